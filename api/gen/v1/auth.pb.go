@@ -590,6 +590,170 @@ func (x *VerifyEmailRequest) GetToken() string {
 	return ""
 }
 
+type InviteUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"` // Optional, can be set when accepting
+	Role          string                 `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"` // Default "user"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InviteUserRequest) Reset() {
+	*x = InviteUserRequest{}
+	mi := &file_api_proto_v1_auth_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InviteUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InviteUserRequest) ProtoMessage() {}
+
+func (x *InviteUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_auth_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InviteUserRequest.ProtoReflect.Descriptor instead.
+func (*InviteUserRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_auth_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *InviteUserRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *InviteUserRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *InviteUserRequest) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+type InviteUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *UserResponse          `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InviteUserResponse) Reset() {
+	*x = InviteUserResponse{}
+	mi := &file_api_proto_v1_auth_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InviteUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InviteUserResponse) ProtoMessage() {}
+
+func (x *InviteUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_auth_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InviteUserResponse.ProtoReflect.Descriptor instead.
+func (*InviteUserResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_auth_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *InviteUserResponse) GetUser() *UserResponse {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+type AcceptInviteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"` // Optional if provided by the inviter
+	Password      string                 `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcceptInviteRequest) Reset() {
+	*x = AcceptInviteRequest{}
+	mi := &file_api_proto_v1_auth_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptInviteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptInviteRequest) ProtoMessage() {}
+
+func (x *AcceptInviteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_auth_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptInviteRequest.ProtoReflect.Descriptor instead.
+func (*AcceptInviteRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_auth_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *AcceptInviteRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *AcceptInviteRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AcceptInviteRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
 type TokenPair_TokenDetail struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
@@ -600,7 +764,7 @@ type TokenPair_TokenDetail struct {
 
 func (x *TokenPair_TokenDetail) Reset() {
 	*x = TokenPair_TokenDetail{}
-	mi := &file_api_proto_v1_auth_proto_msgTypes[12]
+	mi := &file_api_proto_v1_auth_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -612,7 +776,7 @@ func (x *TokenPair_TokenDetail) String() string {
 func (*TokenPair_TokenDetail) ProtoMessage() {}
 
 func (x *TokenPair_TokenDetail) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_v1_auth_proto_msgTypes[12]
+	mi := &file_api_proto_v1_auth_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -646,39 +810,55 @@ var File_api_proto_v1_auth_proto protoreflect.FileDescriptor
 
 const file_api_proto_v1_auth_proto_rawDesc = "" +
 	"\n" +
-	"\x17api/proto/v1/auth.proto\x12\x02v1\x1a\x1cgoogle/api/annotations.proto\x1a\x17api/proto/v1/user.proto\"\a\n" +
+	"\x17api/proto/v1/auth.proto\x12\x02v1\x1a\x1cgoogle/api/annotations.proto\x1a\x17api/proto/v1/user.proto\x1a\x1aapi/proto/v1/options.proto\"\a\n" +
 	"\x05Empty\"+\n" +
 	"\x0fSuccessResponse\x12\x18\n" +
-	"\amessage\x18\x01 \x01(\tR\amessage\"W\n" +
-	"\x0fRegisterRequest\x12\x12\n" +
-	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
-	"\x05email\x18\x02 \x01(\tR\x05email\x12\x1a\n" +
-	"\bpassword\x18\x03 \x01(\tR\bpassword\"@\n" +
-	"\fLoginRequest\x12\x14\n" +
-	"\x05email\x18\x01 \x01(\tR\x05email\x12\x1a\n" +
-	"\bpassword\x18\x02 \x01(\tR\bpassword\"\xb2\x01\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"~\n" +
+	"\x0fRegisterRequest\x12\x1c\n" +
+	"\x04name\x18\x01 \x01(\tB\b\x92\xb5\x18\x04\b\x01\x18dR\x04name\x12!\n" +
+	"\x05email\x18\x02 \x01(\tB\v\x92\xb5\x18\a\b\x01\x18\xfe\x01 \x01R\x05email\x12*\n" +
+	"\bpassword\x18\x03 \x01(\tB\x0e\x92\xb5\x18\x06\b\x01\x10\b@H\x98\xb5\x18\x01R\bpassword\"T\n" +
+	"\fLoginRequest\x12\x1c\n" +
+	"\x05email\x18\x01 \x01(\tB\x06\x92\xb5\x18\x02\b\x01R\x05email\x12&\n" +
+	"\bpassword\x18\x02 \x01(\tB\n" +
+	"\x92\xb5\x18\x02\b\x01\x98\xb5\x18\x01R\bpassword\"\xb8\x01\n" +
 	"\tTokenPair\x121\n" +
 	"\x06access\x18\x01 \x01(\v2\x19.v1.TokenPair.TokenDetailR\x06access\x123\n" +
-	"\arefresh\x18\x02 \x01(\v2\x19.v1.TokenPair.TokenDetailR\arefresh\x1a=\n" +
-	"\vTokenDetail\x12\x14\n" +
-	"\x05token\x18\x01 \x01(\tR\x05token\x12\x18\n" +
+	"\arefresh\x18\x02 \x01(\v2\x19.v1.TokenPair.TokenDetailR\arefresh\x1aC\n" +
+	"\vTokenDetail\x12\x1a\n" +
+	"\x05token\x18\x01 \x01(\tB\x04\x98\xb5\x18\x01R\x05token\x12\x18\n" +
 	"\aexpires\x18\x02 \x01(\tR\aexpires\"[\n" +
 	"\fAuthResponse\x12$\n" +
 	"\x04user\x18\x01 \x01(\v2\x10.v1.UserResponseR\x04user\x12%\n" +
-	"\x06tokens\x18\x02 \x01(\v2\r.v1.TokenPairR\x06tokens\"4\n" +
-	"\rLogoutRequest\x12#\n" +
-	"\rrefresh_token\x18\x01 \x01(\tR\frefreshToken\"*\n" +
+	"\x06tokens\x18\x02 \x01(\v2\r.v1.TokenPairR\x06tokens\"@\n" +
+	"\rLogoutRequest\x12/\n" +
+	"\rrefresh_token\x18\x01 \x01(\tB\n" +
+	"\x92\xb5\x18\x02\b\x01\x98\xb5\x18\x01R\frefreshToken\"*\n" +
 	"\x0eLogoutResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\":\n" +
-	"\x13RefreshTokenRequest\x12#\n" +
-	"\rrefresh_token\x18\x01 \x01(\tR\frefreshToken\"-\n" +
-	"\x15ForgotPasswordRequest\x12\x14\n" +
-	"\x05email\x18\x01 \x01(\tR\x05email\"H\n" +
-	"\x14ResetPasswordRequest\x12\x14\n" +
-	"\x05token\x18\x01 \x01(\tR\x05token\x12\x1a\n" +
-	"\bpassword\x18\x02 \x01(\tR\bpassword\"*\n" +
-	"\x12VerifyEmailRequest\x12\x14\n" +
-	"\x05token\x18\x01 \x01(\tR\x05token2\xde\x05\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"F\n" +
+	"\x13RefreshTokenRequest\x12/\n" +
+	"\rrefresh_token\x18\x01 \x01(\tB\n" +
+	"\x92\xb5\x18\x02\b\x01\x98\xb5\x18\x01R\frefreshToken\":\n" +
+	"\x15ForgotPasswordRequest\x12!\n" +
+	"\x05email\x18\x01 \x01(\tB\v\x92\xb5\x18\a\b\x01\x18\xfe\x01 \x01R\x05email\"d\n" +
+	"\x14ResetPasswordRequest\x12 \n" +
+	"\x05token\x18\x01 \x01(\tB\n" +
+	"\x92\xb5\x18\x02\b\x01\x98\xb5\x18\x01R\x05token\x12*\n" +
+	"\bpassword\x18\x02 \x01(\tB\x0e\x92\xb5\x18\x06\b\x01\x10\b@H\x98\xb5\x18\x01R\bpassword\"6\n" +
+	"\x12VerifyEmailRequest\x12 \n" +
+	"\x05token\x18\x01 \x01(\tB\n" +
+	"\x92\xb5\x18\x02\b\x01\x98\xb5\x18\x01R\x05token\"y\n" +
+	"\x11InviteUserRequest\x12!\n" +
+	"\x05email\x18\x01 \x01(\tB\v\x92\xb5\x18\a\b\x01\x18\xfe\x01 \x01R\x05email\x12\x1a\n" +
+	"\x04name\x18\x02 \x01(\tB\x06\x92\xb5\x18\x02\x18dR\x04name\x12%\n" +
+	"\x04role\x18\x03 \x01(\tB\x11\x92\xb5\x18\r2\x04user2\x05adminR\x04role\":\n" +
+	"\x12InviteUserResponse\x12$\n" +
+	"\x04user\x18\x01 \x01(\v2\x10.v1.UserResponseR\x04user\"\x7f\n" +
+	"\x13AcceptInviteRequest\x12 \n" +
+	"\x05token\x18\x01 \x01(\tB\n" +
+	"\x92\xb5\x18\x02\b\x01\x98\xb5\x18\x01R\x05token\x12\x1a\n" +
+	"\x04name\x18\x02 \x01(\tB\x06\x92\xb5\x18\x02\x18dR\x04name\x12*\n" +
+	"\bpassword\x18\x03 \x01(\tB\x0e\x92\xb5\x18\x06\b\x01\x10\b@H\x98\xb5\x18\x01R\bpassword2\x99\a\n" +
 	"\vAuthService\x12O\n" +
 	"\bRegister\x12\x13.v1.RegisterRequest\x1a\x10.v1.AuthResponse\"\x1c\x82\xd3\xe4\x93\x02\x16:\x01*\"\x11/v1/auth/register\x12F\n" +
 	"\x05Login\x12\x10.v1.LoginRequest\x1a\x10.v1.AuthResponse\"\x19\x82\xd3\xe4\x93\x02\x13:\x01*\"\x0e/v1/auth/login\x12K\n" +
@@ -687,7 +867,10 @@ const file_api_proto_v1_auth_proto_rawDesc = "" +
 	"\x0eForgotPassword\x12\x19.v1.ForgotPasswordRequest\x1a\x13.v1.SuccessResponse\"#\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/v1/auth/forgot-password\x12b\n" +
 	"\rResetPassword\x12\x18.v1.ResetPasswordRequest\x1a\x13.v1.SuccessResponse\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/v1/auth/reset-password\x12d\n" +
 	"\x15SendVerificationEmail\x12\t.v1.Empty\x1a\x13.v1.SuccessResponse\"+\x82\xd3\xe4\x93\x02%:\x01*\" /v1/auth/send-verification-email\x12\\\n" +
-	"\vVerifyEmail\x12\x16.v1.VerifyEmailRequest\x1a\x13.v1.SuccessResponse\" \x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/v1/auth/verify-emailBi\n" +
+	"\vVerifyEmail\x12\x16.v1.VerifyEmailRequest\x1a\x13.v1.SuccessResponse\" \x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/v1/auth/verify-email\x12[\n" +
+	"\n" +
+	"InviteUser\x12\x15.v1.InviteUserRequest\x1a\x16.v1.InviteUserResponse\"\x1e\x88\xb5\x18\x01\x82\xd3\xe4\x93\x02\x14:\x01*\"\x0f/v1/auth/invite\x12\\\n" +
+	"\fAcceptInvite\x12\x17.v1.AcceptInviteRequest\x1a\x10.v1.AuthResponse\"!\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/v1/auth/accept-inviteBi\n" +
 	"\x06com.v1B\tAuthProtoP\x01Z,starter-kit-grpc-golang/api/gen/api/proto/v1\xa2\x02\x03VXX\xaa\x02\x02V1\xca\x02\x02V1\xe2\x02\x0eV1\\GPBMetadata\xea\x02\x02V1b\x06proto3"
 
 var (
@@ -702,7 +885,7 @@ func file_api_proto_v1_auth_proto_rawDescGZIP() []byte {
 	return file_api_proto_v1_auth_proto_rawDescData
 }
 
-var file_api_proto_v1_auth_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_api_proto_v1_auth_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
 var file_api_proto_v1_auth_proto_goTypes = []any{
 	(*Empty)(nil),                 // 0: v1.Empty
 	(*SuccessResponse)(nil),       // 1: v1.SuccessResponse
@@ -716,35 +899,43 @@ var file_api_proto_v1_auth_proto_goTypes = []any{
 	(*ForgotPasswordRequest)(nil), // 9: v1.ForgotPasswordRequest
 	(*ResetPasswordRequest)(nil),  // 10: v1.ResetPasswordRequest
 	(*VerifyEmailRequest)(nil),    // 11: v1.VerifyEmailRequest
-	(*TokenPair_TokenDetail)(nil), // 12: v1.TokenPair.TokenDetail
-	(*UserResponse)(nil),          // 13: v1.UserResponse
+	(*InviteUserRequest)(nil),     // 12: v1.InviteUserRequest
+	(*InviteUserResponse)(nil),    // 13: v1.InviteUserResponse
+	(*AcceptInviteRequest)(nil),   // 14: v1.AcceptInviteRequest
+	(*TokenPair_TokenDetail)(nil), // 15: v1.TokenPair.TokenDetail
+	(*UserResponse)(nil),          // 16: v1.UserResponse
 }
 var file_api_proto_v1_auth_proto_depIdxs = []int32{
-	12, // 0: v1.TokenPair.access:type_name -> v1.TokenPair.TokenDetail
-	12, // 1: v1.TokenPair.refresh:type_name -> v1.TokenPair.TokenDetail
-	13, // 2: v1.AuthResponse.user:type_name -> v1.UserResponse
+	15, // 0: v1.TokenPair.access:type_name -> v1.TokenPair.TokenDetail
+	15, // 1: v1.TokenPair.refresh:type_name -> v1.TokenPair.TokenDetail
+	16, // 2: v1.AuthResponse.user:type_name -> v1.UserResponse
 	4,  // 3: v1.AuthResponse.tokens:type_name -> v1.TokenPair
-	2,  // 4: v1.AuthService.Register:input_type -> v1.RegisterRequest
-	3,  // 5: v1.AuthService.Login:input_type -> v1.LoginRequest
-	6,  // 6: v1.AuthService.Logout:input_type -> v1.LogoutRequest
-	8,  // 7: v1.AuthService.RefreshToken:input_type -> v1.RefreshTokenRequest
-	9,  // 8: v1.AuthService.ForgotPassword:input_type -> v1.ForgotPasswordRequest
-	10, // 9: v1.AuthService.ResetPassword:input_type -> v1.ResetPasswordRequest
-	0,  // 10: v1.AuthService.SendVerificationEmail:input_type -> v1.Empty
-	11, // 11: v1.AuthService.VerifyEmail:input_type -> v1.VerifyEmailRequest
-	5,  // 12: v1.AuthService.Register:output_type -> v1.AuthResponse
-	5,  // 13: v1.AuthService.Login:output_type -> v1.AuthResponse
-	7,  // 14: v1.AuthService.Logout:output_type -> v1.LogoutResponse
-	4,  // 15: v1.AuthService.RefreshToken:output_type -> v1.TokenPair
-	1,  // 16: v1.AuthService.ForgotPassword:output_type -> v1.SuccessResponse
-	1,  // 17: v1.AuthService.ResetPassword:output_type -> v1.SuccessResponse
-	1,  // 18: v1.AuthService.SendVerificationEmail:output_type -> v1.SuccessResponse
-	1,  // 19: v1.AuthService.VerifyEmail:output_type -> v1.SuccessResponse
-	12, // [12:20] is the sub-list for method output_type
-	4,  // [4:12] is the sub-list for method input_type
-	4,  // [4:4] is the sub-list for extension type_name
-	4,  // [4:4] is the sub-list for extension extendee
-	0,  // [0:4] is the sub-list for field type_name
+	16, // 4: v1.InviteUserResponse.user:type_name -> v1.UserResponse
+	2,  // 5: v1.AuthService.Register:input_type -> v1.RegisterRequest
+	3,  // 6: v1.AuthService.Login:input_type -> v1.LoginRequest
+	6,  // 7: v1.AuthService.Logout:input_type -> v1.LogoutRequest
+	8,  // 8: v1.AuthService.RefreshToken:input_type -> v1.RefreshTokenRequest
+	9,  // 9: v1.AuthService.ForgotPassword:input_type -> v1.ForgotPasswordRequest
+	10, // 10: v1.AuthService.ResetPassword:input_type -> v1.ResetPasswordRequest
+	0,  // 11: v1.AuthService.SendVerificationEmail:input_type -> v1.Empty
+	11, // 12: v1.AuthService.VerifyEmail:input_type -> v1.VerifyEmailRequest
+	12, // 13: v1.AuthService.InviteUser:input_type -> v1.InviteUserRequest
+	14, // 14: v1.AuthService.AcceptInvite:input_type -> v1.AcceptInviteRequest
+	5,  // 15: v1.AuthService.Register:output_type -> v1.AuthResponse
+	5,  // 16: v1.AuthService.Login:output_type -> v1.AuthResponse
+	7,  // 17: v1.AuthService.Logout:output_type -> v1.LogoutResponse
+	4,  // 18: v1.AuthService.RefreshToken:output_type -> v1.TokenPair
+	1,  // 19: v1.AuthService.ForgotPassword:output_type -> v1.SuccessResponse
+	1,  // 20: v1.AuthService.ResetPassword:output_type -> v1.SuccessResponse
+	1,  // 21: v1.AuthService.SendVerificationEmail:output_type -> v1.SuccessResponse
+	1,  // 22: v1.AuthService.VerifyEmail:output_type -> v1.SuccessResponse
+	13, // 23: v1.AuthService.InviteUser:output_type -> v1.InviteUserResponse
+	5,  // 24: v1.AuthService.AcceptInvite:output_type -> v1.AuthResponse
+	15, // [15:25] is the sub-list for method output_type
+	5,  // [5:15] is the sub-list for method input_type
+	5,  // [5:5] is the sub-list for extension type_name
+	5,  // [5:5] is the sub-list for extension extendee
+	0,  // [0:5] is the sub-list for field type_name
 }
 
 func init() { file_api_proto_v1_auth_proto_init() }
@@ -753,13 +944,14 @@ func file_api_proto_v1_auth_proto_init() {
 		return
 	}
 	file_api_proto_v1_user_proto_init()
+	file_api_proto_v1_options_proto_init()
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_proto_v1_auth_proto_rawDesc), len(file_api_proto_v1_auth_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   13,
+			NumMessages:   16,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
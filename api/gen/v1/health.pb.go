@@ -60,8 +60,9 @@ func (*HealthCheckRequest) Descriptor() ([]byte, []int) {
 
 type HealthCheckResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"` // "healthy" or "unhealthy"
 	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Checks        map[string]string      `protobuf:"bytes,3,rep,name=checks,proto3" json:"checks,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // Dependency name -> "ok" or "failing"; details are only logged
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -110,15 +111,26 @@ func (x *HealthCheckResponse) GetMessage() string {
 	return ""
 }
 
+func (x *HealthCheckResponse) GetChecks() map[string]string {
+	if x != nil {
+		return x.Checks
+	}
+	return nil
+}
+
 var File_api_proto_v1_health_proto protoreflect.FileDescriptor
 
 const file_api_proto_v1_health_proto_rawDesc = "" +
 	"\n" +
 	"\x19api/proto/v1/health.proto\x12\x02v1\x1a\x1cgoogle/api/annotations.proto\"\x14\n" +
-	"\x12HealthCheckRequest\"G\n" +
+	"\x12HealthCheckRequest\"\xbf\x01\n" +
 	"\x13HealthCheckResponse\x12\x16\n" +
 	"\x06status\x18\x01 \x01(\tR\x06status\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage2c\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12;\n" +
+	"\x06checks\x18\x03 \x03(\v2#.v1.HealthCheckResponse.ChecksEntryR\x06checks\x1a9\n" +
+	"\vChecksEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x012c\n" +
 	"\rHealthService\x12R\n" +
 	"\vHealthCheck\x12\x16.v1.HealthCheckRequest\x1a\x17.v1.HealthCheckResponse\"\x12\x82\xd3\xe4\x93\x02\f\x12\n" +
 	"/v1/healthBk\n" +
@@ -136,19 +148,21 @@ func file_api_proto_v1_health_proto_rawDescGZIP() []byte {
 	return file_api_proto_v1_health_proto_rawDescData
 }
 
-var file_api_proto_v1_health_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_api_proto_v1_health_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
 var file_api_proto_v1_health_proto_goTypes = []any{
 	(*HealthCheckRequest)(nil),  // 0: v1.HealthCheckRequest
 	(*HealthCheckResponse)(nil), // 1: v1.HealthCheckResponse
+	nil,                         // 2: v1.HealthCheckResponse.ChecksEntry
 }
 var file_api_proto_v1_health_proto_depIdxs = []int32{
-	0, // 0: v1.HealthService.HealthCheck:input_type -> v1.HealthCheckRequest
-	1, // 1: v1.HealthService.HealthCheck:output_type -> v1.HealthCheckResponse
-	1, // [1:2] is the sub-list for method output_type
-	0, // [0:1] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	2, // 0: v1.HealthCheckResponse.checks:type_name -> v1.HealthCheckResponse.ChecksEntry
+	0, // 1: v1.HealthService.HealthCheck:input_type -> v1.HealthCheckRequest
+	1, // 2: v1.HealthService.HealthCheck:output_type -> v1.HealthCheckResponse
+	2, // [2:3] is the sub-list for method output_type
+	1, // [1:2] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
 }
 
 func init() { file_api_proto_v1_health_proto_init() }
@@ -162,7 +176,7 @@ func file_api_proto_v1_health_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_proto_v1_health_proto_rawDesc), len(file_api_proto_v1_health_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   3,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
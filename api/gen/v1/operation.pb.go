@@ -0,0 +1,80 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: api/proto/v1/operation.proto
+
+package v1
+
+import (
+	longrunningpb "cloud.google.com/go/longrunning/autogen/longrunningpb"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	reflect "reflect"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+var File_api_proto_v1_operation_proto protoreflect.FileDescriptor
+
+const file_api_proto_v1_operation_proto_rawDesc = "" +
+	"\n" +
+	"\x1capi/proto/v1/operation.proto\x12\x02v1\x1a\x1cgoogle/api/annotations.proto\x1a#google/longrunning/operations.proto\x1a\x1bgoogle/protobuf/empty.proto2\x8f\x03\n" +
+	"\x10OperationService\x12\x7f\n" +
+	"\x0eListOperations\x12).google.longrunning.ListOperationsRequest\x1a*.google.longrunning.ListOperationsResponse\"\x16\x82\xd3\xe4\x93\x02\x10\x12\x0e/v1/operations\x12w\n" +
+	"\fGetOperation\x12'.google.longrunning.GetOperationRequest\x1a\x1d.google.longrunning.Operation\"\x1f\x82\xd3\xe4\x93\x02\x19\x12\x17/v1/{name=operations/*}\x12\x80\x01\n" +
+	"\x0fCancelOperation\x12*.google.longrunning.CancelOperationRequest\x1a\x16.google.protobuf.Empty\")\x82\xd3\xe4\x93\x02#:\x01*\"\x1e/v1/{name=operations/*}:cancelBn\n" +
+	"\x06com.v1B\x0eOperationProtoP\x01Z,starter-kit-grpc-golang/api/gen/api/proto/v1\xa2\x02\x03VXX\xaa\x02\x02V1\xca\x02\x02V1\xe2\x02\x0eV1\\GPBMetadata\xea\x02\x02V1b\x06proto3"
+
+var file_api_proto_v1_operation_proto_goTypes = []any{
+	(*longrunningpb.ListOperationsRequest)(nil),  // 0: google.longrunning.ListOperationsRequest
+	(*longrunningpb.GetOperationRequest)(nil),    // 1: google.longrunning.GetOperationRequest
+	(*longrunningpb.CancelOperationRequest)(nil), // 2: google.longrunning.CancelOperationRequest
+	(*longrunningpb.ListOperationsResponse)(nil), // 3: google.longrunning.ListOperationsResponse
+	(*longrunningpb.Operation)(nil),              // 4: google.longrunning.Operation
+	(*emptypb.Empty)(nil),                        // 5: google.protobuf.Empty
+}
+var file_api_proto_v1_operation_proto_depIdxs = []int32{
+	0, // 0: v1.OperationService.ListOperations:input_type -> google.longrunning.ListOperationsRequest
+	1, // 1: v1.OperationService.GetOperation:input_type -> google.longrunning.GetOperationRequest
+	2, // 2: v1.OperationService.CancelOperation:input_type -> google.longrunning.CancelOperationRequest
+	3, // 3: v1.OperationService.ListOperations:output_type -> google.longrunning.ListOperationsResponse
+	4, // 4: v1.OperationService.GetOperation:output_type -> google.longrunning.Operation
+	5, // 5: v1.OperationService.CancelOperation:output_type -> google.protobuf.Empty
+	3, // [3:6] is the sub-list for method output_type
+	0, // [0:3] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_api_proto_v1_operation_proto_init() }
+func file_api_proto_v1_operation_proto_init() {
+	if File_api_proto_v1_operation_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_proto_v1_operation_proto_rawDesc), len(file_api_proto_v1_operation_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   0,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_api_proto_v1_operation_proto_goTypes,
+		DependencyIndexes: file_api_proto_v1_operation_proto_depIdxs,
+	}.Build()
+	File_api_proto_v1_operation_proto = out.File
+	file_api_proto_v1_operation_proto_goTypes = nil
+	file_api_proto_v1_operation_proto_depIdxs = nil
+}
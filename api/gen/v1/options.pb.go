@@ -0,0 +1,247 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: api/proto/v1/options.proto
+
+package v1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	descriptorpb "google.golang.org/protobuf/types/descriptorpb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// FieldRules declare validation constraints on a request field (enforced by ValidationInterceptor).
+// Except for required, rules only apply to fields that are set (non-empty), so optional
+// fields and partial updates are left alone.
+type FieldRules struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Required      bool                   `protobuf:"varint,1,opt,name=required,proto3" json:"required,omitempty"`                 // Must be set; for messages, present
+	MinLen        uint32                 `protobuf:"varint,2,opt,name=min_len,json=minLen,proto3" json:"min_len,omitempty"`       // Minimum length in characters
+	MaxLen        uint32                 `protobuf:"varint,3,opt,name=max_len,json=maxLen,proto3" json:"max_len,omitempty"`       // Maximum length in characters
+	Email         bool                   `protobuf:"varint,4,opt,name=email,proto3" json:"email,omitempty"`                       // Must be an email address
+	Uuid          bool                   `protobuf:"varint,5,opt,name=uuid,proto3" json:"uuid,omitempty"`                         // Must be a UUID
+	In            []string               `protobuf:"bytes,6,rep,name=in,proto3" json:"in,omitempty"`                              // Must be one of these values
+	MaxItems      uint32                 `protobuf:"varint,7,opt,name=max_items,json=maxItems,proto3" json:"max_items,omitempty"` // Maximum number of elements (repeated fields)
+	MaxBytes      uint32                 `protobuf:"varint,8,opt,name=max_bytes,json=maxBytes,proto3" json:"max_bytes,omitempty"` // Maximum length in UTF-8 bytes, e.g. bcrypt's 72-byte limit
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FieldRules) Reset() {
+	*x = FieldRules{}
+	mi := &file_api_proto_v1_options_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FieldRules) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FieldRules) ProtoMessage() {}
+
+func (x *FieldRules) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_options_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FieldRules.ProtoReflect.Descriptor instead.
+func (*FieldRules) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_options_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *FieldRules) GetRequired() bool {
+	if x != nil {
+		return x.Required
+	}
+	return false
+}
+
+func (x *FieldRules) GetMinLen() uint32 {
+	if x != nil {
+		return x.MinLen
+	}
+	return 0
+}
+
+func (x *FieldRules) GetMaxLen() uint32 {
+	if x != nil {
+		return x.MaxLen
+	}
+	return 0
+}
+
+func (x *FieldRules) GetEmail() bool {
+	if x != nil {
+		return x.Email
+	}
+	return false
+}
+
+func (x *FieldRules) GetUuid() bool {
+	if x != nil {
+		return x.Uuid
+	}
+	return false
+}
+
+func (x *FieldRules) GetIn() []string {
+	if x != nil {
+		return x.In
+	}
+	return nil
+}
+
+func (x *FieldRules) GetMaxItems() uint32 {
+	if x != nil {
+		return x.MaxItems
+	}
+	return 0
+}
+
+func (x *FieldRules) GetMaxBytes() uint32 {
+	if x != nil {
+		return x.MaxBytes
+	}
+	return 0
+}
+
+var file_api_proto_v1_options_proto_extTypes = []protoimpl.ExtensionInfo{
+	{
+		ExtendedType:  (*descriptorpb.MethodOptions)(nil),
+		ExtensionType: (*bool)(nil),
+		Field:         50001,
+		Name:          "v1.requires_verified_email",
+		Tag:           "varint,50001,opt,name=requires_verified_email",
+		Filename:      "api/proto/v1/options.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.FieldOptions)(nil),
+		ExtensionType: (*FieldRules)(nil),
+		Field:         50002,
+		Name:          "v1.rules",
+		Tag:           "bytes,50002,opt,name=rules",
+		Filename:      "api/proto/v1/options.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.FieldOptions)(nil),
+		ExtensionType: (*bool)(nil),
+		Field:         50003,
+		Name:          "v1.sensitive",
+		Tag:           "varint,50003,opt,name=sensitive",
+		Filename:      "api/proto/v1/options.proto",
+	},
+}
+
+// Extension fields to descriptorpb.MethodOptions.
+var (
+	// Caller must have a verified email (enforced by EmailVerificationInterceptor)
+	//
+	// optional bool requires_verified_email = 50001;
+	E_RequiresVerifiedEmail = &file_api_proto_v1_options_proto_extTypes[0]
+)
+
+// Extension fields to descriptorpb.FieldOptions.
+var (
+	// optional v1.FieldRules rules = 50002;
+	E_Rules = &file_api_proto_v1_options_proto_extTypes[1]
+	// Secret value (password, token) that must never appear in logs or crash reports;
+	// log redaction replaces it. Email fields are masked without needing this.
+	//
+	// optional bool sensitive = 50003;
+	E_Sensitive = &file_api_proto_v1_options_proto_extTypes[2]
+)
+
+var File_api_proto_v1_options_proto protoreflect.FileDescriptor
+
+const file_api_proto_v1_options_proto_rawDesc = "" +
+	"\n" +
+	"\x1aapi/proto/v1/options.proto\x12\x02v1\x1a google/protobuf/descriptor.proto\"\xce\x01\n" +
+	"\n" +
+	"FieldRules\x12\x1a\n" +
+	"\brequired\x18\x01 \x01(\bR\brequired\x12\x17\n" +
+	"\amin_len\x18\x02 \x01(\rR\x06minLen\x12\x17\n" +
+	"\amax_len\x18\x03 \x01(\rR\x06maxLen\x12\x14\n" +
+	"\x05email\x18\x04 \x01(\bR\x05email\x12\x12\n" +
+	"\x04uuid\x18\x05 \x01(\bR\x04uuid\x12\x0e\n" +
+	"\x02in\x18\x06 \x03(\tR\x02in\x12\x1b\n" +
+	"\tmax_items\x18\a \x01(\rR\bmaxItems\x12\x1b\n" +
+	"\tmax_bytes\x18\b \x01(\rR\bmaxBytes:X\n" +
+	"\x17requires_verified_email\x12\x1e.google.protobuf.MethodOptions\x18ц\x03 \x01(\bR\x15requiresVerifiedEmail:E\n" +
+	"\x05rules\x12\x1d.google.protobuf.FieldOptions\x18҆\x03 \x01(\v2\x0e.v1.FieldRulesR\x05rules:=\n" +
+	"\tsensitive\x12\x1d.google.protobuf.FieldOptions\x18ӆ\x03 \x01(\bR\tsensitiveBl\n" +
+	"\x06com.v1B\fOptionsProtoP\x01Z,starter-kit-grpc-golang/api/gen/api/proto/v1\xa2\x02\x03VXX\xaa\x02\x02V1\xca\x02\x02V1\xe2\x02\x0eV1\\GPBMetadata\xea\x02\x02V1b\x06proto3"
+
+var (
+	file_api_proto_v1_options_proto_rawDescOnce sync.Once
+	file_api_proto_v1_options_proto_rawDescData []byte
+)
+
+func file_api_proto_v1_options_proto_rawDescGZIP() []byte {
+	file_api_proto_v1_options_proto_rawDescOnce.Do(func() {
+		file_api_proto_v1_options_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_api_proto_v1_options_proto_rawDesc), len(file_api_proto_v1_options_proto_rawDesc)))
+	})
+	return file_api_proto_v1_options_proto_rawDescData
+}
+
+var file_api_proto_v1_options_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_api_proto_v1_options_proto_goTypes = []any{
+	(*FieldRules)(nil),                 // 0: v1.FieldRules
+	(*descriptorpb.MethodOptions)(nil), // 1: google.protobuf.MethodOptions
+	(*descriptorpb.FieldOptions)(nil),  // 2: google.protobuf.FieldOptions
+}
+var file_api_proto_v1_options_proto_depIdxs = []int32{
+	1, // 0: v1.requires_verified_email:extendee -> google.protobuf.MethodOptions
+	2, // 1: v1.rules:extendee -> google.protobuf.FieldOptions
+	2, // 2: v1.sensitive:extendee -> google.protobuf.FieldOptions
+	0, // 3: v1.rules:type_name -> v1.FieldRules
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	3, // [3:4] is the sub-list for extension type_name
+	0, // [0:3] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_api_proto_v1_options_proto_init() }
+func file_api_proto_v1_options_proto_init() {
+	if File_api_proto_v1_options_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_proto_v1_options_proto_rawDesc), len(file_api_proto_v1_options_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 3,
+			NumServices:   0,
+		},
+		GoTypes:           file_api_proto_v1_options_proto_goTypes,
+		DependencyIndexes: file_api_proto_v1_options_proto_depIdxs,
+		MessageInfos:      file_api_proto_v1_options_proto_msgTypes,
+		ExtensionInfos:    file_api_proto_v1_options_proto_extTypes,
+	}.Build()
+	File_api_proto_v1_options_proto = out.File
+	file_api_proto_v1_options_proto_goTypes = nil
+	file_api_proto_v1_options_proto_depIdxs = nil
+}
@@ -7,9 +7,12 @@
 package v1
 
 import (
+	longrunningpb "cloud.google.com/go/longrunning/autogen/longrunningpb"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
+	status "google.golang.org/genproto/googleapis/rpc/status"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
@@ -32,8 +35,14 @@ type UserResponse struct {
 	IsEmailVerified bool                   `protobuf:"varint,5,opt,name=is_email_verified,json=isEmailVerified,proto3" json:"is_email_verified,omitempty"`
 	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	UpdatedAt       *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+	Status          string                 `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"` // "active", "suspended" or "deactivated"
+	StatusReason    string                 `protobuf:"bytes,9,opt,name=status_reason,json=statusReason,proto3" json:"status_reason,omitempty"`
+	DeletedAt       *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"` // Only set for soft-deleted users
+	// Changes on every update (AIP-154). Send it back on UpdateUser/DeleteUser, or as an
+	// If-Match header, to fail with ABORTED (HTTP 409) instead of overwriting a newer version.
+	Etag          string `protobuf:"bytes,11,opt,name=etag,proto3" json:"etag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *UserResponse) Reset() {
@@ -115,12 +124,40 @@ func (x *UserResponse) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *UserResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *UserResponse) GetStatusReason() string {
+	if x != nil {
+		return x.StatusReason
+	}
+	return ""
+}
+
+func (x *UserResponse) GetDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
+func (x *UserResponse) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
 type CreateUserRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	Email         string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
 	Password      string                 `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
-	Role          string                 `protobuf:"bytes,4,opt,name=role,proto3" json:"role,omitempty"` // "user" or "admin"
+	Role          string                 `protobuf:"bytes,4,opt,name=role,proto3" json:"role,omitempty"` // Default "user"
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -228,13 +265,26 @@ func (x *GetUserRequest) GetId() string {
 }
 
 type ListUsersRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
-	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
-	Sort          string                 `protobuf:"bytes,3,opt,name=sort,proto3" json:"sort,omitempty"`     // e.g. "created_at:desc"
-	Search        string                 `protobuf:"bytes,4,opt,name=search,proto3" json:"search,omitempty"` // Search keyword
-	Role          string                 `protobuf:"bytes,5,opt,name=role,proto3" json:"role,omitempty"`     // Filter by role
-	Scope         string                 `protobuf:"bytes,6,opt,name=scope,proto3" json:"scope,omitempty"`   // Search scope: "name", "email", "id", or "all"
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Page   int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	Limit  int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Sort   string                 `protobuf:"bytes,3,opt,name=sort,proto3" json:"sort,omitempty"`     // Deprecated alias of order_by, e.g. "created_at:desc"
+	Search string                 `protobuf:"bytes,4,opt,name=search,proto3" json:"search,omitempty"` // Full-text prefix search, ranked by relevance unless sorted
+	Role   string                 `protobuf:"bytes,5,opt,name=role,proto3" json:"role,omitempty"`     // Filter by role
+	Scope  string                 `protobuf:"bytes,6,opt,name=scope,proto3" json:"scope,omitempty"`   // Search scope
+	// Opaque token from a previous next_page_token (AIP-158). Takes precedence over page.
+	// limit is the page size; other parameters must match the original request.
+	PageToken string `protobuf:"bytes,7,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// Count total_results/total_pages (costs a COUNT query). Always counted for
+	// legacy page numbers > 1; otherwise, including the first page, only on request.
+	// Uncounted totals are returned as 0.
+	IncludeTotal bool `protobuf:"varint,8,opt,name=include_total,json=includeTotal,proto3" json:"include_total,omitempty"`
+	// AIP-160 filter, e.g. role = "admin" AND created_at > "2024-01-01T00:00:00Z".
+	// Fields: id, name, email, role, status, is_email_verified, created_at, updated_at.
+	Filter string `protobuf:"bytes,9,opt,name=filter,proto3" json:"filter,omitempty"`
+	// AIP-132 sort keys, e.g. "role asc, name desc". Defaults to "created_at desc";
+	// id is always appended as a tiebreaker. Takes precedence over sort.
+	OrderBy       string `protobuf:"bytes,10,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -311,13 +361,44 @@ func (x *ListUsersRequest) GetScope() string {
 	return ""
 }
 
+func (x *ListUsersRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListUsersRequest) GetIncludeTotal() bool {
+	if x != nil {
+		return x.IncludeTotal
+	}
+	return false
+}
+
+func (x *ListUsersRequest) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+func (x *ListUsersRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
 type ListUsersResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Results       []*UserResponse        `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
-	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
-	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
-	TotalPages    int32                  `protobuf:"varint,4,opt,name=total_pages,json=totalPages,proto3" json:"total_pages,omitempty"`
-	TotalResults  int64                  `protobuf:"varint,5,opt,name=total_results,json=totalResults,proto3" json:"total_results,omitempty"`
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Results []*UserResponse        `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	Page    int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	Limit   int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	// 0 when the total wasn't counted (see include_total), so 0 doesn't mean the
+	// list is empty; use next_page_token to tell whether more results follow.
+	TotalPages    int32  `protobuf:"varint,4,opt,name=total_pages,json=totalPages,proto3" json:"total_pages,omitempty"`
+	TotalResults  int64  `protobuf:"varint,5,opt,name=total_results,json=totalResults,proto3" json:"total_results,omitempty"`     // 0 when not counted, as for total_pages
+	NextPageToken string `protobuf:"bytes,6,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // Empty on the last page
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -387,30 +468,38 @@ func (x *ListUsersResponse) GetTotalResults() int64 {
 	return 0
 }
 
-type UpdateUserRequest struct {
+func (x *ListUsersResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// Batch requests run in one transaction. By default the batch is all-or-nothing and
+// the first failing item fails the call. With allow_partial, failed items are skipped
+// and reported in their result's status.
+type BatchGetUsersRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"` // From URL
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Email         string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
-	Password      string                 `protobuf:"bytes,4,opt,name=password,proto3" json:"password,omitempty"`
+	Ids           []string               `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+	AllowPartial  bool                   `protobuf:"varint,2,opt,name=allow_partial,json=allowPartial,proto3" json:"allow_partial,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateUserRequest) Reset() {
-	*x = UpdateUserRequest{}
+func (x *BatchGetUsersRequest) Reset() {
+	*x = BatchGetUsersRequest{}
 	mi := &file_api_proto_v1_user_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateUserRequest) String() string {
+func (x *BatchGetUsersRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateUserRequest) ProtoMessage() {}
+func (*BatchGetUsersRequest) ProtoMessage() {}
 
-func (x *UpdateUserRequest) ProtoReflect() protoreflect.Message {
+func (x *BatchGetUsersRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_proto_v1_user_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -422,61 +511,100 @@ func (x *UpdateUserRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateUserRequest.ProtoReflect.Descriptor instead.
-func (*UpdateUserRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use BatchGetUsersRequest.ProtoReflect.Descriptor instead.
+func (*BatchGetUsersRequest) Descriptor() ([]byte, []int) {
 	return file_api_proto_v1_user_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *UpdateUserRequest) GetId() string {
+func (x *BatchGetUsersRequest) GetIds() []string {
 	if x != nil {
-		return x.Id
+		return x.Ids
 	}
-	return ""
+	return nil
 }
 
-func (x *UpdateUserRequest) GetName() string {
+func (x *BatchGetUsersRequest) GetAllowPartial() bool {
 	if x != nil {
-		return x.Name
+		return x.AllowPartial
 	}
-	return ""
+	return false
 }
 
-func (x *UpdateUserRequest) GetEmail() string {
+type BatchCreateUsersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Requests      []*CreateUserRequest   `protobuf:"bytes,1,rep,name=requests,proto3" json:"requests,omitempty"`
+	AllowPartial  bool                   `protobuf:"varint,2,opt,name=allow_partial,json=allowPartial,proto3" json:"allow_partial,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchCreateUsersRequest) Reset() {
+	*x = BatchCreateUsersRequest{}
+	mi := &file_api_proto_v1_user_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchCreateUsersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchCreateUsersRequest) ProtoMessage() {}
+
+func (x *BatchCreateUsersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_user_proto_msgTypes[6]
 	if x != nil {
-		return x.Email
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *UpdateUserRequest) GetPassword() string {
+// Deprecated: Use BatchCreateUsersRequest.ProtoReflect.Descriptor instead.
+func (*BatchCreateUsersRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_user_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *BatchCreateUsersRequest) GetRequests() []*CreateUserRequest {
 	if x != nil {
-		return x.Password
+		return x.Requests
 	}
-	return ""
+	return nil
 }
 
-type DeleteUserRequest struct {
+func (x *BatchCreateUsersRequest) GetAllowPartial() bool {
+	if x != nil {
+		return x.AllowPartial
+	}
+	return false
+}
+
+type BatchDeleteUsersRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Ids           []string               `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+	AllowPartial  bool                   `protobuf:"varint,2,opt,name=allow_partial,json=allowPartial,proto3" json:"allow_partial,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteUserRequest) Reset() {
-	*x = DeleteUserRequest{}
-	mi := &file_api_proto_v1_user_proto_msgTypes[6]
+func (x *BatchDeleteUsersRequest) Reset() {
+	*x = BatchDeleteUsersRequest{}
+	mi := &file_api_proto_v1_user_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteUserRequest) String() string {
+func (x *BatchDeleteUsersRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteUserRequest) ProtoMessage() {}
+func (*BatchDeleteUsersRequest) ProtoMessage() {}
 
-func (x *DeleteUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_v1_user_proto_msgTypes[6]
+func (x *BatchDeleteUsersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_user_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -487,40 +615,49 @@ func (x *DeleteUserRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteUserRequest.ProtoReflect.Descriptor instead.
-func (*DeleteUserRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_v1_user_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use BatchDeleteUsersRequest.ProtoReflect.Descriptor instead.
+func (*BatchDeleteUsersRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_user_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *DeleteUserRequest) GetId() string {
+func (x *BatchDeleteUsersRequest) GetIds() []string {
 	if x != nil {
-		return x.Id
+		return x.Ids
 	}
-	return ""
+	return nil
 }
 
-type DeleteUserResponse struct {
+func (x *BatchDeleteUsersRequest) GetAllowPartial() bool {
+	if x != nil {
+		return x.AllowPartial
+	}
+	return false
+}
+
+// BatchUserResult is the outcome of one batch item
+type BatchUserResult struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	User          *UserResponse          `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`     // Unset if the item failed, and for deletes
+	Status        *status.Status         `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"` // code 0 (OK) on success
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteUserResponse) Reset() {
-	*x = DeleteUserResponse{}
-	mi := &file_api_proto_v1_user_proto_msgTypes[7]
+func (x *BatchUserResult) Reset() {
+	*x = BatchUserResult{}
+	mi := &file_api_proto_v1_user_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteUserResponse) String() string {
+func (x *BatchUserResult) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteUserResponse) ProtoMessage() {}
+func (*BatchUserResult) ProtoMessage() {}
 
-func (x *DeleteUserResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_v1_user_proto_msgTypes[7]
+func (x *BatchUserResult) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_user_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -531,72 +668,1126 @@ func (x *DeleteUserResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteUserResponse.ProtoReflect.Descriptor instead.
-func (*DeleteUserResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_v1_user_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use BatchUserResult.ProtoReflect.Descriptor instead.
+func (*BatchUserResult) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_user_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *DeleteUserResponse) GetSuccess() bool {
+func (x *BatchUserResult) GetUser() *UserResponse {
 	if x != nil {
-		return x.Success
+		return x.User
+	}
+	return nil
+}
+
+func (x *BatchUserResult) GetStatus() *status.Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+type BatchUsersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Results       []*BatchUserResult     `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"` // Same order as the request
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchUsersResponse) Reset() {
+	*x = BatchUsersResponse{}
+	mi := &file_api_proto_v1_user_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchUsersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchUsersResponse) ProtoMessage() {}
+
+func (x *BatchUsersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_user_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchUsersResponse.ProtoReflect.Descriptor instead.
+func (*BatchUsersResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_user_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *BatchUsersResponse) GetResults() []*BatchUserResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+// CSV needs a header row with at least name and email; role and password are optional.
+// NDJSON has one {"name", "email", "role", "password"} object per line.
+type ImportUsersRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Format  string                 `protobuf:"bytes,1,opt,name=format,proto3" json:"format,omitempty"`
+	Content []byte                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"` // May hold passwords
+	// Rows without a password get an invite email instead of failing
+	SendInvites   bool `protobuf:"varint,3,opt,name=send_invites,json=sendInvites,proto3" json:"send_invites,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportUsersRequest) Reset() {
+	*x = ImportUsersRequest{}
+	mi := &file_api_proto_v1_user_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportUsersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportUsersRequest) ProtoMessage() {}
+
+func (x *ImportUsersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_user_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportUsersRequest.ProtoReflect.Descriptor instead.
+func (*ImportUsersRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_user_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ImportUsersRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *ImportUsersRequest) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+func (x *ImportUsersRequest) GetSendInvites() bool {
+	if x != nil {
+		return x.SendInvites
 	}
 	return false
 }
 
-var File_api_proto_v1_user_proto protoreflect.FileDescriptor
+type ImportUsersMetadata struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TotalRows     int32                  `protobuf:"varint,1,opt,name=total_rows,json=totalRows,proto3" json:"total_rows,omitempty"`
+	ProcessedRows int32                  `protobuf:"varint,2,opt,name=processed_rows,json=processedRows,proto3" json:"processed_rows,omitempty"`
+	CreateTime    *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	UpdateTime    *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_api_proto_v1_user_proto_rawDesc = "" +
-	"\n" +
-	"\x17api/proto/v1/user.proto\x12\x02v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xfe\x01\n" +
-	"\fUserResponse\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
-	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
-	"\x05email\x18\x03 \x01(\tR\x05email\x12\x12\n" +
-	"\x04role\x18\x04 \x01(\tR\x04role\x12*\n" +
-	"\x11is_email_verified\x18\x05 \x01(\bR\x0fisEmailVerified\x129\n" +
-	"\n" +
-	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
-	"\n" +
-	"updated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"m\n" +
-	"\x11CreateUserRequest\x12\x12\n" +
-	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
-	"\x05email\x18\x02 \x01(\tR\x05email\x12\x1a\n" +
-	"\bpassword\x18\x03 \x01(\tR\bpassword\x12\x12\n" +
-	"\x04role\x18\x04 \x01(\tR\x04role\" \n" +
-	"\x0eGetUserRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"\x92\x01\n" +
-	"\x10ListUsersRequest\x12\x12\n" +
-	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x14\n" +
-	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x12\n" +
-	"\x04sort\x18\x03 \x01(\tR\x04sort\x12\x16\n" +
-	"\x06search\x18\x04 \x01(\tR\x06search\x12\x12\n" +
-	"\x04role\x18\x05 \x01(\tR\x04role\x12\x14\n" +
-	"\x05scope\x18\x06 \x01(\tR\x05scope\"\xaf\x01\n" +
-	"\x11ListUsersResponse\x12*\n" +
-	"\aresults\x18\x01 \x03(\v2\x10.v1.UserResponseR\aresults\x12\x12\n" +
-	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x14\n" +
-	"\x05limit\x18\x03 \x01(\x05R\x05limit\x12\x1f\n" +
-	"\vtotal_pages\x18\x04 \x01(\x05R\n" +
-	"totalPages\x12#\n" +
-	"\rtotal_results\x18\x05 \x01(\x03R\ftotalResults\"i\n" +
-	"\x11UpdateUserRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
-	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
-	"\x05email\x18\x03 \x01(\tR\x05email\x12\x1a\n" +
-	"\bpassword\x18\x04 \x01(\tR\bpassword\"#\n" +
-	"\x11DeleteUserRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\".\n" +
-	"\x12DeleteUserResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess2\x97\x03\n" +
-	"\vUserService\x12K\n" +
-	"\n" +
-	"CreateUser\x12\x15.v1.CreateUserRequest\x1a\x10.v1.UserResponse\"\x14\x82\xd3\xe4\x93\x02\x0e:\x01*\"\t/v1/users\x12G\n" +
-	"\aGetUser\x12\x12.v1.GetUserRequest\x1a\x10.v1.UserResponse\"\x16\x82\xd3\xe4\x93\x02\x10\x12\x0e/v1/users/{id}\x12K\n" +
-	"\tListUsers\x12\x14.v1.ListUsersRequest\x1a\x15.v1.ListUsersResponse\"\x11\x82\xd3\xe4\x93\x02\v\x12\t/v1/users\x12P\n" +
+func (x *ImportUsersMetadata) Reset() {
+	*x = ImportUsersMetadata{}
+	mi := &file_api_proto_v1_user_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportUsersMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportUsersMetadata) ProtoMessage() {}
+
+func (x *ImportUsersMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_user_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportUsersMetadata.ProtoReflect.Descriptor instead.
+func (*ImportUsersMetadata) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_user_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ImportUsersMetadata) GetTotalRows() int32 {
+	if x != nil {
+		return x.TotalRows
+	}
+	return 0
+}
+
+func (x *ImportUsersMetadata) GetProcessedRows() int32 {
+	if x != nil {
+		return x.ProcessedRows
+	}
+	return 0
+}
+
+func (x *ImportUsersMetadata) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *ImportUsersMetadata) GetUpdateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return nil
+}
+
+type ImportUsersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CreatedCount  int32                  `protobuf:"varint,1,opt,name=created_count,json=createdCount,proto3" json:"created_count,omitempty"`
+	InvitedCount  int32                  `protobuf:"varint,2,opt,name=invited_count,json=invitedCount,proto3" json:"invited_count,omitempty"`
+	FailedCount   int32                  `protobuf:"varint,3,opt,name=failed_count,json=failedCount,proto3" json:"failed_count,omitempty"`
+	Errors        []*ImportRowError      `protobuf:"bytes,4,rep,name=errors,proto3" json:"errors,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportUsersResponse) Reset() {
+	*x = ImportUsersResponse{}
+	mi := &file_api_proto_v1_user_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportUsersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportUsersResponse) ProtoMessage() {}
+
+func (x *ImportUsersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_user_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportUsersResponse.ProtoReflect.Descriptor instead.
+func (*ImportUsersResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_user_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ImportUsersResponse) GetCreatedCount() int32 {
+	if x != nil {
+		return x.CreatedCount
+	}
+	return 0
+}
+
+func (x *ImportUsersResponse) GetInvitedCount() int32 {
+	if x != nil {
+		return x.InvitedCount
+	}
+	return 0
+}
+
+func (x *ImportUsersResponse) GetFailedCount() int32 {
+	if x != nil {
+		return x.FailedCount
+	}
+	return 0
+}
+
+func (x *ImportUsersResponse) GetErrors() []*ImportRowError {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+// ImportRowError reports a skipped row. Rows are numbered from 1, excluding the CSV header.
+type ImportRowError struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Row           int32                  `protobuf:"varint,1,opt,name=row,proto3" json:"row,omitempty"`
+	Email         string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportRowError) Reset() {
+	*x = ImportRowError{}
+	mi := &file_api_proto_v1_user_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportRowError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportRowError) ProtoMessage() {}
+
+func (x *ImportRowError) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_user_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportRowError.ProtoReflect.Descriptor instead.
+func (*ImportRowError) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_user_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ImportRowError) GetRow() int32 {
+	if x != nil {
+		return x.Row
+	}
+	return 0
+}
+
+func (x *ImportRowError) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *ImportRowError) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type ExportUsersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Format        string                 `protobuf:"bytes,1,opt,name=format,proto3" json:"format,omitempty"`
+	Filter        string                 `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter,omitempty"` // Same AIP-160 filter as ListUsers
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportUsersRequest) Reset() {
+	*x = ExportUsersRequest{}
+	mi := &file_api_proto_v1_user_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportUsersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportUsersRequest) ProtoMessage() {}
+
+func (x *ExportUsersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_user_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportUsersRequest.ProtoReflect.Descriptor instead.
+func (*ExportUsersRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_user_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ExportUsersRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *ExportUsersRequest) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+type ExportUsersMetadata struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ExportedRows  int32                  `protobuf:"varint,1,opt,name=exported_rows,json=exportedRows,proto3" json:"exported_rows,omitempty"`
+	CreateTime    *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	UpdateTime    *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportUsersMetadata) Reset() {
+	*x = ExportUsersMetadata{}
+	mi := &file_api_proto_v1_user_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportUsersMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportUsersMetadata) ProtoMessage() {}
+
+func (x *ExportUsersMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_user_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportUsersMetadata.ProtoReflect.Descriptor instead.
+func (*ExportUsersMetadata) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_user_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ExportUsersMetadata) GetExportedRows() int32 {
+	if x != nil {
+		return x.ExportedRows
+	}
+	return 0
+}
+
+func (x *ExportUsersMetadata) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *ExportUsersMetadata) GetUpdateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return nil
+}
+
+type ExportUsersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Format        string                 `protobuf:"bytes,1,opt,name=format,proto3" json:"format,omitempty"`
+	UserCount     int32                  `protobuf:"varint,2,opt,name=user_count,json=userCount,proto3" json:"user_count,omitempty"`
+	Content       []byte                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportUsersResponse) Reset() {
+	*x = ExportUsersResponse{}
+	mi := &file_api_proto_v1_user_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportUsersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportUsersResponse) ProtoMessage() {}
+
+func (x *ExportUsersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_user_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportUsersResponse.ProtoReflect.Descriptor instead.
+func (*ExportUsersResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_user_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ExportUsersResponse) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *ExportUsersResponse) GetUserCount() int32 {
+	if x != nil {
+		return x.UserCount
+	}
+	return 0
+}
+
+func (x *ExportUsersResponse) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+type WatchUsersRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// resume_token of the last event received, to continue after a reconnect.
+	// Empty starts from now. An expired token fails with OUT_OF_RANGE: list users and watch again.
+	ResumeToken   string `protobuf:"bytes,1,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchUsersRequest) Reset() {
+	*x = WatchUsersRequest{}
+	mi := &file_api_proto_v1_user_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchUsersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchUsersRequest) ProtoMessage() {}
+
+func (x *WatchUsersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_user_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchUsersRequest.ProtoReflect.Descriptor instead.
+func (*WatchUsersRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_user_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *WatchUsersRequest) GetResumeToken() string {
+	if x != nil {
+		return x.ResumeToken
+	}
+	return ""
+}
+
+type UserEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"` // "created", "updated" or "deleted"
+	User          *UserResponse          `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"` // Deleted events only carry the id
+	OccurredAt    *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	ResumeToken   string                 `protobuf:"bytes,4,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserEvent) Reset() {
+	*x = UserEvent{}
+	mi := &file_api_proto_v1_user_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserEvent) ProtoMessage() {}
+
+func (x *UserEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_user_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserEvent.ProtoReflect.Descriptor instead.
+func (*UserEvent) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_user_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *UserEvent) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *UserEvent) GetUser() *UserResponse {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *UserEvent) GetOccurredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return nil
+}
+
+func (x *UserEvent) GetResumeToken() string {
+	if x != nil {
+		return x.ResumeToken
+	}
+	return ""
+}
+
+// UserUpdate holds the fields UpdateUser can change
+type UserUpdate struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Email         string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	Password      string                 `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserUpdate) Reset() {
+	*x = UserUpdate{}
+	mi := &file_api_proto_v1_user_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserUpdate) ProtoMessage() {}
+
+func (x *UserUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_user_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserUpdate.ProtoReflect.Descriptor instead.
+func (*UserUpdate) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_user_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *UserUpdate) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UserUpdate) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *UserUpdate) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+type UpdateUserRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"` // From URL
+	// Deprecated: use user + update_mask. Empty strings mean "not provided".
+	//
+	// Deprecated: Marked as deprecated in api/proto/v1/user.proto.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Deprecated: Marked as deprecated in api/proto/v1/user.proto.
+	Email string `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	// Deprecated: Marked as deprecated in api/proto/v1/user.proto.
+	Password string      `protobuf:"bytes,4,opt,name=password,proto3" json:"password,omitempty"`
+	User     *UserUpdate `protobuf:"bytes,5,opt,name=user,proto3" json:"user,omitempty"`
+	// Fields of user to apply (AIP-134). Filled from the PATCH body by the gateway.
+	// If empty, every non-empty field of user is applied. "*" applies all fields.
+	UpdateMask *fieldmaskpb.FieldMask `protobuf:"bytes,6,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	// Apply only if the user still has this etag. Falls back to the If-Match header.
+	Etag          string `protobuf:"bytes,7,opt,name=etag,proto3" json:"etag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateUserRequest) Reset() {
+	*x = UpdateUserRequest{}
+	mi := &file_api_proto_v1_user_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateUserRequest) ProtoMessage() {}
+
+func (x *UpdateUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_user_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateUserRequest.ProtoReflect.Descriptor instead.
+func (*UpdateUserRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_user_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *UpdateUserRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// Deprecated: Marked as deprecated in api/proto/v1/user.proto.
+func (x *UpdateUserRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// Deprecated: Marked as deprecated in api/proto/v1/user.proto.
+func (x *UpdateUserRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+// Deprecated: Marked as deprecated in api/proto/v1/user.proto.
+func (x *UpdateUserRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *UpdateUserRequest) GetUser() *UserUpdate {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *UpdateUserRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+func (x *UpdateUserRequest) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+type DeleteUserRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Delete only if the user still has this etag. Falls back to the If-Match header.
+	Etag          string `protobuf:"bytes,2,opt,name=etag,proto3" json:"etag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteUserRequest) Reset() {
+	*x = DeleteUserRequest{}
+	mi := &file_api_proto_v1_user_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteUserRequest) ProtoMessage() {}
+
+func (x *DeleteUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_user_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteUserRequest.ProtoReflect.Descriptor instead.
+func (*DeleteUserRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_user_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *DeleteUserRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DeleteUserRequest) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+type DeleteUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteUserResponse) Reset() {
+	*x = DeleteUserResponse{}
+	mi := &file_api_proto_v1_user_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteUserResponse) ProtoMessage() {}
+
+func (x *DeleteUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_user_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteUserResponse.ProtoReflect.Descriptor instead.
+func (*DeleteUserResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_user_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *DeleteUserResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type UndeleteUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UndeleteUserRequest) Reset() {
+	*x = UndeleteUserRequest{}
+	mi := &file_api_proto_v1_user_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UndeleteUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UndeleteUserRequest) ProtoMessage() {}
+
+func (x *UndeleteUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_user_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UndeleteUserRequest.ProtoReflect.Descriptor instead.
+func (*UndeleteUserRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_user_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *UndeleteUserRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ChangeUserStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"` // From URL
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChangeUserStatusRequest) Reset() {
+	*x = ChangeUserStatusRequest{}
+	mi := &file_api_proto_v1_user_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangeUserStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeUserStatusRequest) ProtoMessage() {}
+
+func (x *ChangeUserStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_user_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeUserStatusRequest.ProtoReflect.Descriptor instead.
+func (*ChangeUserStatusRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_user_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ChangeUserStatusRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ChangeUserStatusRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+var File_api_proto_v1_user_proto protoreflect.FileDescriptor
+
+const file_api_proto_v1_user_proto_rawDesc = "" +
+	"\n" +
+	"\x17api/proto/v1/user.proto\x12\x02v1\x1a\x1cgoogle/api/annotations.proto\x1a#google/longrunning/operations.proto\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x17google/rpc/status.proto\x1a\x1aapi/proto/v1/options.proto\"\x8a\x03\n" +
+	"\fUserResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05email\x18\x03 \x01(\tR\x05email\x12\x12\n" +
+	"\x04role\x18\x04 \x01(\tR\x04role\x12*\n" +
+	"\x11is_email_verified\x18\x05 \x01(\bR\x0fisEmailVerified\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x16\n" +
+	"\x06status\x18\b \x01(\tR\x06status\x12#\n" +
+	"\rstatus_reason\x18\t \x01(\tR\fstatusReason\x129\n" +
+	"\n" +
+	"deleted_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tdeletedAt\x12\x12\n" +
+	"\x04etag\x18\v \x01(\tR\x04etag\"\xa7\x01\n" +
+	"\x11CreateUserRequest\x12\x1c\n" +
+	"\x04name\x18\x01 \x01(\tB\b\x92\xb5\x18\x04\b\x01\x18dR\x04name\x12!\n" +
+	"\x05email\x18\x02 \x01(\tB\v\x92\xb5\x18\a\b\x01\x18\xfe\x01 \x01R\x05email\x12*\n" +
+	"\bpassword\x18\x03 \x01(\tB\x0e\x92\xb5\x18\x06\b\x01\x10\b@H\x98\xb5\x18\x01R\bpassword\x12%\n" +
+	"\x04role\x18\x04 \x01(\tB\x11\x92\xb5\x18\r2\x04user2\x05adminR\x04role\"*\n" +
+	"\x0eGetUserRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\x92\xb5\x18\x04\b\x01(\x01R\x02id\"\xca\x02\n" +
+	"\x10ListUsersRequest\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x12\n" +
+	"\x04sort\x18\x03 \x01(\tR\x04sort\x12\x1f\n" +
+	"\x06search\x18\x04 \x01(\tB\a\x92\xb5\x18\x03\x18\x80\x02R\x06search\x12%\n" +
+	"\x04role\x18\x05 \x01(\tB\x11\x92\xb5\x18\r2\x04user2\x05adminR\x04role\x120\n" +
+	"\x05scope\x18\x06 \x01(\tB\x1a\x92\xb5\x18\x162\x04name2\x05email2\x02id2\x03allR\x05scope\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\a \x01(\tR\tpageToken\x12#\n" +
+	"\rinclude_total\x18\b \x01(\bR\fincludeTotal\x12\x1f\n" +
+	"\x06filter\x18\t \x01(\tB\a\x92\xb5\x18\x03\x18\x80\bR\x06filter\x12\x19\n" +
+	"\border_by\x18\n" +
+	" \x01(\tR\aorderBy\"\xd7\x01\n" +
+	"\x11ListUsersResponse\x12*\n" +
+	"\aresults\x18\x01 \x03(\v2\x10.v1.UserResponseR\aresults\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\x12\x1f\n" +
+	"\vtotal_pages\x18\x04 \x01(\x05R\n" +
+	"totalPages\x12#\n" +
+	"\rtotal_results\x18\x05 \x01(\x03R\ftotalResults\x12&\n" +
+	"\x0fnext_page_token\x18\x06 \x01(\tR\rnextPageToken\"U\n" +
+	"\x14BatchGetUsersRequest\x12\x18\n" +
+	"\x03ids\x18\x01 \x03(\tB\x06\x92\xb5\x18\x02\b\x01R\x03ids\x12#\n" +
+	"\rallow_partial\x18\x02 \x01(\bR\fallowPartial\"y\n" +
+	"\x17BatchCreateUsersRequest\x129\n" +
+	"\brequests\x18\x01 \x03(\v2\x15.v1.CreateUserRequestB\x06\x92\xb5\x18\x02\b\x01R\brequests\x12#\n" +
+	"\rallow_partial\x18\x02 \x01(\bR\fallowPartial\"X\n" +
+	"\x17BatchDeleteUsersRequest\x12\x18\n" +
+	"\x03ids\x18\x01 \x03(\tB\x06\x92\xb5\x18\x02\b\x01R\x03ids\x12#\n" +
+	"\rallow_partial\x18\x02 \x01(\bR\fallowPartial\"c\n" +
+	"\x0fBatchUserResult\x12$\n" +
+	"\x04user\x18\x01 \x01(\v2\x10.v1.UserResponseR\x04user\x12*\n" +
+	"\x06status\x18\x02 \x01(\v2\x12.google.rpc.StatusR\x06status\"C\n" +
+	"\x12BatchUsersResponse\x12-\n" +
+	"\aresults\x18\x01 \x03(\v2\x13.v1.BatchUserResultR\aresults\"\x8a\x01\n" +
+	"\x12ImportUsersRequest\x12+\n" +
+	"\x06format\x18\x01 \x01(\tB\x13\x92\xb5\x18\x0f\b\x012\x03csv2\x06ndjsonR\x06format\x12$\n" +
+	"\acontent\x18\x02 \x01(\fB\n" +
+	"\x92\xb5\x18\x02\b\x01\x98\xb5\x18\x01R\acontent\x12!\n" +
+	"\fsend_invites\x18\x03 \x01(\bR\vsendInvites\"\xd5\x01\n" +
+	"\x13ImportUsersMetadata\x12\x1d\n" +
+	"\n" +
+	"total_rows\x18\x01 \x01(\x05R\ttotalRows\x12%\n" +
+	"\x0eprocessed_rows\x18\x02 \x01(\x05R\rprocessedRows\x12;\n" +
+	"\vcreate_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"createTime\x12;\n" +
+	"\vupdate_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"updateTime\"\xae\x01\n" +
+	"\x13ImportUsersResponse\x12#\n" +
+	"\rcreated_count\x18\x01 \x01(\x05R\fcreatedCount\x12#\n" +
+	"\rinvited_count\x18\x02 \x01(\x05R\finvitedCount\x12!\n" +
+	"\ffailed_count\x18\x03 \x01(\x05R\vfailedCount\x12*\n" +
+	"\x06errors\x18\x04 \x03(\v2\x12.v1.ImportRowErrorR\x06errors\"R\n" +
+	"\x0eImportRowError\x12\x10\n" +
+	"\x03row\x18\x01 \x01(\x05R\x03row\x12\x14\n" +
+	"\x05email\x18\x02 \x01(\tR\x05email\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\"b\n" +
+	"\x12ExportUsersRequest\x12+\n" +
+	"\x06format\x18\x01 \x01(\tB\x13\x92\xb5\x18\x0f\b\x012\x03csv2\x06ndjsonR\x06format\x12\x1f\n" +
+	"\x06filter\x18\x02 \x01(\tB\a\x92\xb5\x18\x03\x18\x80\bR\x06filter\"\xb4\x01\n" +
+	"\x13ExportUsersMetadata\x12#\n" +
+	"\rexported_rows\x18\x01 \x01(\x05R\fexportedRows\x12;\n" +
+	"\vcreate_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"createTime\x12;\n" +
+	"\vupdate_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"updateTime\"l\n" +
+	"\x13ExportUsersResponse\x12\x16\n" +
+	"\x06format\x18\x01 \x01(\tR\x06format\x12\x1d\n" +
+	"\n" +
+	"user_count\x18\x02 \x01(\x05R\tuserCount\x12\x1e\n" +
+	"\acontent\x18\x03 \x01(\fB\x04\x98\xb5\x18\x01R\acontent\"6\n" +
+	"\x11WatchUsersRequest\x12!\n" +
+	"\fresume_token\x18\x01 \x01(\tR\vresumeToken\"\xa5\x01\n" +
+	"\tUserEvent\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12$\n" +
+	"\x04user\x18\x02 \x01(\v2\x10.v1.UserResponseR\x04user\x12;\n" +
+	"\voccurred_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"occurredAt\x12!\n" +
+	"\fresume_token\x18\x04 \x01(\tR\vresumeToken\"s\n" +
+	"\n" +
+	"UserUpdate\x12\x1a\n" +
+	"\x04name\x18\x01 \x01(\tB\x06\x92\xb5\x18\x02\x18dR\x04name\x12\x1f\n" +
+	"\x05email\x18\x02 \x01(\tB\t\x92\xb5\x18\x05\x18\xfe\x01 \x01R\x05email\x12(\n" +
+	"\bpassword\x18\x03 \x01(\tB\f\x92\xb5\x18\x04\x10\b@H\x98\xb5\x18\x01R\bpassword\"\x8f\x02\n" +
+	"\x11UpdateUserRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\x92\xb5\x18\x04\b\x01(\x01R\x02id\x12\x1c\n" +
+	"\x04name\x18\x02 \x01(\tB\b\x92\xb5\x18\x02\x18d\x18\x01R\x04name\x12!\n" +
+	"\x05email\x18\x03 \x01(\tB\v\x92\xb5\x18\x05\x18\xfe\x01 \x01\x18\x01R\x05email\x12*\n" +
+	"\bpassword\x18\x04 \x01(\tB\x0e\x92\xb5\x18\x04\x10\b@H\x98\xb5\x18\x01\x18\x01R\bpassword\x12\"\n" +
+	"\x04user\x18\x05 \x01(\v2\x0e.v1.UserUpdateR\x04user\x12;\n" +
+	"\vupdate_mask\x18\x06 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\x12\x12\n" +
+	"\x04etag\x18\a \x01(\tR\x04etag\"A\n" +
+	"\x11DeleteUserRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\x92\xb5\x18\x04\b\x01(\x01R\x02id\x12\x12\n" +
+	"\x04etag\x18\x02 \x01(\tR\x04etag\".\n" +
+	"\x12DeleteUserResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"/\n" +
+	"\x13UndeleteUserRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\x92\xb5\x18\x04\b\x01(\x01R\x02id\"T\n" +
+	"\x17ChangeUserStatusRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\x92\xb5\x18\x04\b\x01(\x01R\x02id\x12\x1f\n" +
+	"\x06reason\x18\x02 \x01(\tB\a\x92\xb5\x18\x03\x18\xf4\x03R\x06reason2\xae\f\n" +
+	"\vUserService\x12K\n" +
+	"\n" +
+	"CreateUser\x12\x15.v1.CreateUserRequest\x1a\x10.v1.UserResponse\"\x14\x82\xd3\xe4\x93\x02\x0e:\x01*\"\t/v1/users\x12G\n" +
+	"\aGetUser\x12\x12.v1.GetUserRequest\x1a\x10.v1.UserResponse\"\x16\x82\xd3\xe4\x93\x02\x10\x12\x0e/v1/users/{id}\x12K\n" +
+	"\tListUsers\x12\x14.v1.ListUsersRequest\x1a\x15.v1.ListUsersResponse\"\x11\x82\xd3\xe4\x93\x02\v\x12\t/v1/users\x12]\n" +
+	"\rBatchGetUsers\x12\x18.v1.BatchGetUsersRequest\x1a\x16.v1.BatchUsersResponse\"\x1a\x82\xd3\xe4\x93\x02\x14\x12\x12/v1/users:batchGet\x12i\n" +
+	"\x10BatchCreateUsers\x12\x1b.v1.BatchCreateUsersRequest\x1a\x16.v1.BatchUsersResponse\" \x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/v1/users:batchCreate\x12i\n" +
+	"\x10BatchDeleteUsers\x12\x1b.v1.BatchDeleteUsersRequest\x1a\x16.v1.BatchUsersResponse\" \x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/v1/users:batchDelete\x12\x8e\x01\n" +
+	"\vImportUsers\x12\x16.v1.ImportUsersRequest\x1a\x1d.google.longrunning.Operation\"H\xcaA*\n" +
+	"\x13ImportUsersResponse\x12\x13ImportUsersMetadata\x82\xd3\xe4\x93\x02\x15:\x01*\"\x10/v1/users:import\x12\x8e\x01\n" +
+	"\vExportUsers\x12\x16.v1.ExportUsersRequest\x1a\x1d.google.longrunning.Operation\"H\xcaA*\n" +
+	"\x13ExportUsersResponse\x12\x13ExportUsersMetadata\x82\xd3\xe4\x93\x02\x15:\x01*\"\x10/v1/users:export\x12M\n" +
+	"\n" +
+	"WatchUsers\x12\x15.v1.WatchUsersRequest\x1a\r.v1.UserEvent\"\x17\x82\xd3\xe4\x93\x02\x11\x12\x0f/v1/users:watch0\x01\x12S\n" +
 	"\n" +
-	"UpdateUser\x12\x15.v1.UpdateUserRequest\x1a\x10.v1.UserResponse\"\x19\x82\xd3\xe4\x93\x02\x13:\x01*2\x0e/v1/users/{id}\x12S\n" +
+	"UpdateUser\x12\x15.v1.UpdateUserRequest\x1a\x10.v1.UserResponse\"\x1c\x82\xd3\xe4\x93\x02\x16:\x04user2\x0e/v1/users/{id}\x12S\n" +
 	"\n" +
-	"DeleteUser\x12\x15.v1.DeleteUserRequest\x1a\x16.v1.DeleteUserResponse\"\x16\x82\xd3\xe4\x93\x02\x10*\x0e/v1/users/{id}Bi\n" +
+	"DeleteUser\x12\x15.v1.DeleteUserRequest\x1a\x16.v1.DeleteUserResponse\"\x16\x82\xd3\xe4\x93\x02\x10*\x0e/v1/users/{id}\x12]\n" +
+	"\fUndeleteUser\x12\x17.v1.UndeleteUserRequest\x1a\x10.v1.UserResponse\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/v1/users/{id}:undelete\x12^\n" +
+	"\x10ListDeletedUsers\x12\x14.v1.ListUsersRequest\x1a\x15.v1.ListUsersResponse\"\x1d\x82\xd3\xe4\x93\x02\x17\x12\x15/v1/users:listDeleted\x12_\n" +
+	"\vSuspendUser\x12\x1b.v1.ChangeUserStatusRequest\x1a\x10.v1.UserResponse\"!\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/v1/users/{id}:suspend\x12e\n" +
+	"\x0eDeactivateUser\x12\x1b.v1.ChangeUserStatusRequest\x1a\x10.v1.UserResponse\"$\x82\xd3\xe4\x93\x02\x1e:\x01*\"\x19/v1/users/{id}:deactivate\x12e\n" +
+	"\x0eReactivateUser\x12\x1b.v1.ChangeUserStatusRequest\x1a\x10.v1.UserResponse\"$\x82\xd3\xe4\x93\x02\x1e:\x01*\"\x19/v1/users/{id}:reactivateBi\n" +
 	"\x06com.v1B\tUserProtoP\x01Z,starter-kit-grpc-golang/api/gen/api/proto/v1\xa2\x02\x03VXX\xaa\x02\x02V1\xca\x02\x02V1\xe2\x02\x0eV1\\GPBMetadata\xea\x02\x02V1b\x06proto3"
 
 var (
@@ -611,37 +1802,93 @@ func file_api_proto_v1_user_proto_rawDescGZIP() []byte {
 	return file_api_proto_v1_user_proto_rawDescData
 }
 
-var file_api_proto_v1_user_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_api_proto_v1_user_proto_msgTypes = make([]protoimpl.MessageInfo, 25)
 var file_api_proto_v1_user_proto_goTypes = []any{
-	(*UserResponse)(nil),          // 0: v1.UserResponse
-	(*CreateUserRequest)(nil),     // 1: v1.CreateUserRequest
-	(*GetUserRequest)(nil),        // 2: v1.GetUserRequest
-	(*ListUsersRequest)(nil),      // 3: v1.ListUsersRequest
-	(*ListUsersResponse)(nil),     // 4: v1.ListUsersResponse
-	(*UpdateUserRequest)(nil),     // 5: v1.UpdateUserRequest
-	(*DeleteUserRequest)(nil),     // 6: v1.DeleteUserRequest
-	(*DeleteUserResponse)(nil),    // 7: v1.DeleteUserResponse
-	(*timestamppb.Timestamp)(nil), // 8: google.protobuf.Timestamp
+	(*UserResponse)(nil),            // 0: v1.UserResponse
+	(*CreateUserRequest)(nil),       // 1: v1.CreateUserRequest
+	(*GetUserRequest)(nil),          // 2: v1.GetUserRequest
+	(*ListUsersRequest)(nil),        // 3: v1.ListUsersRequest
+	(*ListUsersResponse)(nil),       // 4: v1.ListUsersResponse
+	(*BatchGetUsersRequest)(nil),    // 5: v1.BatchGetUsersRequest
+	(*BatchCreateUsersRequest)(nil), // 6: v1.BatchCreateUsersRequest
+	(*BatchDeleteUsersRequest)(nil), // 7: v1.BatchDeleteUsersRequest
+	(*BatchUserResult)(nil),         // 8: v1.BatchUserResult
+	(*BatchUsersResponse)(nil),      // 9: v1.BatchUsersResponse
+	(*ImportUsersRequest)(nil),      // 10: v1.ImportUsersRequest
+	(*ImportUsersMetadata)(nil),     // 11: v1.ImportUsersMetadata
+	(*ImportUsersResponse)(nil),     // 12: v1.ImportUsersResponse
+	(*ImportRowError)(nil),          // 13: v1.ImportRowError
+	(*ExportUsersRequest)(nil),      // 14: v1.ExportUsersRequest
+	(*ExportUsersMetadata)(nil),     // 15: v1.ExportUsersMetadata
+	(*ExportUsersResponse)(nil),     // 16: v1.ExportUsersResponse
+	(*WatchUsersRequest)(nil),       // 17: v1.WatchUsersRequest
+	(*UserEvent)(nil),               // 18: v1.UserEvent
+	(*UserUpdate)(nil),              // 19: v1.UserUpdate
+	(*UpdateUserRequest)(nil),       // 20: v1.UpdateUserRequest
+	(*DeleteUserRequest)(nil),       // 21: v1.DeleteUserRequest
+	(*DeleteUserResponse)(nil),      // 22: v1.DeleteUserResponse
+	(*UndeleteUserRequest)(nil),     // 23: v1.UndeleteUserRequest
+	(*ChangeUserStatusRequest)(nil), // 24: v1.ChangeUserStatusRequest
+	(*timestamppb.Timestamp)(nil),   // 25: google.protobuf.Timestamp
+	(*status.Status)(nil),           // 26: google.rpc.Status
+	(*fieldmaskpb.FieldMask)(nil),   // 27: google.protobuf.FieldMask
+	(*longrunningpb.Operation)(nil), // 28: google.longrunning.Operation
 }
 var file_api_proto_v1_user_proto_depIdxs = []int32{
-	8, // 0: v1.UserResponse.created_at:type_name -> google.protobuf.Timestamp
-	8, // 1: v1.UserResponse.updated_at:type_name -> google.protobuf.Timestamp
-	0, // 2: v1.ListUsersResponse.results:type_name -> v1.UserResponse
-	1, // 3: v1.UserService.CreateUser:input_type -> v1.CreateUserRequest
-	2, // 4: v1.UserService.GetUser:input_type -> v1.GetUserRequest
-	3, // 5: v1.UserService.ListUsers:input_type -> v1.ListUsersRequest
-	5, // 6: v1.UserService.UpdateUser:input_type -> v1.UpdateUserRequest
-	6, // 7: v1.UserService.DeleteUser:input_type -> v1.DeleteUserRequest
-	0, // 8: v1.UserService.CreateUser:output_type -> v1.UserResponse
-	0, // 9: v1.UserService.GetUser:output_type -> v1.UserResponse
-	4, // 10: v1.UserService.ListUsers:output_type -> v1.ListUsersResponse
-	0, // 11: v1.UserService.UpdateUser:output_type -> v1.UserResponse
-	7, // 12: v1.UserService.DeleteUser:output_type -> v1.DeleteUserResponse
-	8, // [8:13] is the sub-list for method output_type
-	3, // [3:8] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	25, // 0: v1.UserResponse.created_at:type_name -> google.protobuf.Timestamp
+	25, // 1: v1.UserResponse.updated_at:type_name -> google.protobuf.Timestamp
+	25, // 2: v1.UserResponse.deleted_at:type_name -> google.protobuf.Timestamp
+	0,  // 3: v1.ListUsersResponse.results:type_name -> v1.UserResponse
+	1,  // 4: v1.BatchCreateUsersRequest.requests:type_name -> v1.CreateUserRequest
+	0,  // 5: v1.BatchUserResult.user:type_name -> v1.UserResponse
+	26, // 6: v1.BatchUserResult.status:type_name -> google.rpc.Status
+	8,  // 7: v1.BatchUsersResponse.results:type_name -> v1.BatchUserResult
+	25, // 8: v1.ImportUsersMetadata.create_time:type_name -> google.protobuf.Timestamp
+	25, // 9: v1.ImportUsersMetadata.update_time:type_name -> google.protobuf.Timestamp
+	13, // 10: v1.ImportUsersResponse.errors:type_name -> v1.ImportRowError
+	25, // 11: v1.ExportUsersMetadata.create_time:type_name -> google.protobuf.Timestamp
+	25, // 12: v1.ExportUsersMetadata.update_time:type_name -> google.protobuf.Timestamp
+	0,  // 13: v1.UserEvent.user:type_name -> v1.UserResponse
+	25, // 14: v1.UserEvent.occurred_at:type_name -> google.protobuf.Timestamp
+	19, // 15: v1.UpdateUserRequest.user:type_name -> v1.UserUpdate
+	27, // 16: v1.UpdateUserRequest.update_mask:type_name -> google.protobuf.FieldMask
+	1,  // 17: v1.UserService.CreateUser:input_type -> v1.CreateUserRequest
+	2,  // 18: v1.UserService.GetUser:input_type -> v1.GetUserRequest
+	3,  // 19: v1.UserService.ListUsers:input_type -> v1.ListUsersRequest
+	5,  // 20: v1.UserService.BatchGetUsers:input_type -> v1.BatchGetUsersRequest
+	6,  // 21: v1.UserService.BatchCreateUsers:input_type -> v1.BatchCreateUsersRequest
+	7,  // 22: v1.UserService.BatchDeleteUsers:input_type -> v1.BatchDeleteUsersRequest
+	10, // 23: v1.UserService.ImportUsers:input_type -> v1.ImportUsersRequest
+	14, // 24: v1.UserService.ExportUsers:input_type -> v1.ExportUsersRequest
+	17, // 25: v1.UserService.WatchUsers:input_type -> v1.WatchUsersRequest
+	20, // 26: v1.UserService.UpdateUser:input_type -> v1.UpdateUserRequest
+	21, // 27: v1.UserService.DeleteUser:input_type -> v1.DeleteUserRequest
+	23, // 28: v1.UserService.UndeleteUser:input_type -> v1.UndeleteUserRequest
+	3,  // 29: v1.UserService.ListDeletedUsers:input_type -> v1.ListUsersRequest
+	24, // 30: v1.UserService.SuspendUser:input_type -> v1.ChangeUserStatusRequest
+	24, // 31: v1.UserService.DeactivateUser:input_type -> v1.ChangeUserStatusRequest
+	24, // 32: v1.UserService.ReactivateUser:input_type -> v1.ChangeUserStatusRequest
+	0,  // 33: v1.UserService.CreateUser:output_type -> v1.UserResponse
+	0,  // 34: v1.UserService.GetUser:output_type -> v1.UserResponse
+	4,  // 35: v1.UserService.ListUsers:output_type -> v1.ListUsersResponse
+	9,  // 36: v1.UserService.BatchGetUsers:output_type -> v1.BatchUsersResponse
+	9,  // 37: v1.UserService.BatchCreateUsers:output_type -> v1.BatchUsersResponse
+	9,  // 38: v1.UserService.BatchDeleteUsers:output_type -> v1.BatchUsersResponse
+	28, // 39: v1.UserService.ImportUsers:output_type -> google.longrunning.Operation
+	28, // 40: v1.UserService.ExportUsers:output_type -> google.longrunning.Operation
+	18, // 41: v1.UserService.WatchUsers:output_type -> v1.UserEvent
+	0,  // 42: v1.UserService.UpdateUser:output_type -> v1.UserResponse
+	22, // 43: v1.UserService.DeleteUser:output_type -> v1.DeleteUserResponse
+	0,  // 44: v1.UserService.UndeleteUser:output_type -> v1.UserResponse
+	4,  // 45: v1.UserService.ListDeletedUsers:output_type -> v1.ListUsersResponse
+	0,  // 46: v1.UserService.SuspendUser:output_type -> v1.UserResponse
+	0,  // 47: v1.UserService.DeactivateUser:output_type -> v1.UserResponse
+	0,  // 48: v1.UserService.ReactivateUser:output_type -> v1.UserResponse
+	33, // [33:49] is the sub-list for method output_type
+	17, // [17:33] is the sub-list for method input_type
+	17, // [17:17] is the sub-list for extension type_name
+	17, // [17:17] is the sub-list for extension extendee
+	0,  // [0:17] is the sub-list for field type_name
 }
 
 func init() { file_api_proto_v1_user_proto_init() }
@@ -649,13 +1896,14 @@ func file_api_proto_v1_user_proto_init() {
 	if File_api_proto_v1_user_proto != nil {
 		return
 	}
+	file_api_proto_v1_options_proto_init()
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_proto_v1_user_proto_rawDesc), len(file_api_proto_v1_user_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   8,
+			NumMessages:   25,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
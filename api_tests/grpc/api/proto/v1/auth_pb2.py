@@ -24,9 +24,10 @@ _sym_db = _symbol_database.Default()
 
 from google.api import annotations_pb2 as google_dot_api_dot_annotations__pb2
 from api.proto.v1 import user_pb2 as api_dot_proto_dot_v1_dot_user__pb2
+from api.proto.v1 import options_pb2 as api_dot_proto_dot_v1_dot_options__pb2
 
 
-DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n\x17\x61pi/proto/v1/auth.proto\x12\x02v1\x1a\x1cgoogle/api/annotations.proto\x1a\x17\x61pi/proto/v1/user.proto\"\x07\n\x05\x45mpty\"\"\n\x0fSuccessResponse\x12\x0f\n\x07message\x18\x01 \x01(\t\"@\n\x0fRegisterRequest\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\r\n\x05\x65mail\x18\x02 \x01(\t\x12\x10\n\x08password\x18\x03 \x01(\t\"/\n\x0cLoginRequest\x12\r\n\x05\x65mail\x18\x01 \x01(\t\x12\x10\n\x08password\x18\x02 \x01(\t\"\x91\x01\n\tTokenPair\x12)\n\x06\x61\x63\x63\x65ss\x18\x01 \x01(\x0b\x32\x19.v1.TokenPair.TokenDetail\x12*\n\x07refresh\x18\x02 \x01(\x0b\x32\x19.v1.TokenPair.TokenDetail\x1a-\n\x0bTokenDetail\x12\r\n\x05token\x18\x01 \x01(\t\x12\x0f\n\x07\x65xpires\x18\x02 \x01(\t\"M\n\x0c\x41uthResponse\x12\x1e\n\x04user\x18\x01 \x01(\x0b\x32\x10.v1.UserResponse\x12\x1d\n\x06tokens\x18\x02 \x01(\x0b\x32\r.v1.TokenPair\"&\n\rLogoutRequest\x12\x15\n\rrefresh_token\x18\x01 \x01(\t\"!\n\x0eLogoutResponse\x12\x0f\n\x07success\x18\x01 \x01(\x08\",\n\x13RefreshTokenRequest\x12\x15\n\rrefresh_token\x18\x01 \x01(\t\"&\n\x15\x46orgotPasswordRequest\x12\r\n\x05\x65mail\x18\x01 \x01(\t\"7\n\x14ResetPasswordRequest\x12\r\n\x05token\x18\x01 \x01(\t\x12\x10\n\x08password\x18\x02 \x01(\t\"#\n\x12VerifyEmailRequest\x12\r\n\x05token\x18\x01 \x01(\t2\xde\x05\n\x0b\x41uthService\x12O\n\x08Register\x12\x13.v1.RegisterRequest\x1a\x10.v1.AuthResponse\"\x1c\x82\xd3\xe4\x93\x02\x16\"\x11/v1/auth/register:\x01*\x12\x46\n\x05Login\x12\x10.v1.LoginRequest\x1a\x10.v1.AuthResponse\"\x19\x82\xd3\xe4\x93\x02\x13\"\x0e/v1/auth/login:\x01*\x12K\n\x06Logout\x12\x11.v1.LogoutRequest\x1a\x12.v1.LogoutResponse\"\x1a\x82\xd3\xe4\x93\x02\x14\"\x0f/v1/auth/logout:\x01*\x12Z\n\x0cRefreshToken\x12\x17.v1.RefreshTokenRequest\x1a\r.v1.TokenPair\"\"\x82\xd3\xe4\x93\x02\x1c\"\x17/v1/auth/refresh-tokens:\x01*\x12\x65\n\x0e\x46orgotPassword\x12\x19.v1.ForgotPasswordRequest\x1a\x13.v1.SuccessResponse\"#\x82\xd3\xe4\x93\x02\x1d\"\x18/v1/auth/forgot-password:\x01*\x12\x62\n\rResetPassword\x12\x18.v1.ResetPasswordRequest\x1a\x13.v1.SuccessResponse\"\"\x82\xd3\xe4\x93\x02\x1c\"\x17/v1/auth/reset-password:\x01*\x12\x64\n\x15SendVerificationEmail\x12\t.v1.Empty\x1a\x13.v1.SuccessResponse\"+\x82\xd3\xe4\x93\x02%\" /v1/auth/send-verification-email:\x01*\x12\\\n\x0bVerifyEmail\x12\x16.v1.VerifyEmailRequest\x1a\x13.v1.SuccessResponse\" \x82\xd3\xe4\x93\x02\x1a\"\x15/v1/auth/verify-email:\x01*B\'Z%starter-kit-grpc-golang/api/gen/v1;v1b\x06proto3')
+DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n\x17\x61pi/proto/v1/auth.proto\x12\x02v1\x1a\x1cgoogle/api/annotations.proto\x1a\x17\x61pi/proto/v1/user.proto\x1a\x1a\x61pi/proto/v1/options.proto\"\x07\n\x05\x45mpty\"\"\n\x0fSuccessResponse\x12\x0f\n\x07message\x18\x01 \x01(\t\"g\n\x0fRegisterRequest\x12\x16\n\x04name\x18\x01 \x01(\tB\x08\x92\xb5\x18\x04\x08\x01\x18\x64\x12\x1a\n\x05\x65mail\x18\x02 \x01(\tB\x0b\x92\xb5\x18\x07\x08\x01\x18\xfe\x01 \x01\x12 \n\x08password\x18\x03 \x01(\tB\x0e\x92\xb5\x18\x06\x08\x01\x10\x08@H\x98\xb5\x18\x01\"C\n\x0cLoginRequest\x12\x15\n\x05\x65mail\x18\x01 \x01(\tB\x06\x92\xb5\x18\x02\x08\x01\x12\x1c\n\x08password\x18\x02 \x01(\tB\n\x92\xb5\x18\x02\x08\x01\x98\xb5\x18\x01\"\x97\x01\n\tTokenPair\x12)\n\x06\x61\x63\x63\x65ss\x18\x01 \x01(\x0b\x32\x19.v1.TokenPair.TokenDetail\x12*\n\x07refresh\x18\x02 \x01(\x0b\x32\x19.v1.TokenPair.TokenDetail\x1a\x33\n\x0bTokenDetail\x12\x13\n\x05token\x18\x01 \x01(\tB\x04\x98\xb5\x18\x01\x12\x0f\n\x07\x65xpires\x18\x02 \x01(\t\"M\n\x0c\x41uthResponse\x12\x1e\n\x04user\x18\x01 \x01(\x0b\x32\x10.v1.UserResponse\x12\x1d\n\x06tokens\x18\x02 \x01(\x0b\x32\r.v1.TokenPair\"2\n\rLogoutRequest\x12!\n\rrefresh_token\x18\x01 \x01(\tB\n\x92\xb5\x18\x02\x08\x01\x98\xb5\x18\x01\"!\n\x0eLogoutResponse\x12\x0f\n\x07success\x18\x01 \x01(\x08\"8\n\x13RefreshTokenRequest\x12!\n\rrefresh_token\x18\x01 \x01(\tB\n\x92\xb5\x18\x02\x08\x01\x98\xb5\x18\x01\"3\n\x15\x46orgotPasswordRequest\x12\x1a\n\x05\x65mail\x18\x01 \x01(\tB\x0b\x92\xb5\x18\x07\x08\x01\x18\xfe\x01 \x01\"S\n\x14ResetPasswordRequest\x12\x19\n\x05token\x18\x01 \x01(\tB\n\x92\xb5\x18\x02\x08\x01\x98\xb5\x18\x01\x12 \n\x08password\x18\x02 \x01(\tB\x0e\x92\xb5\x18\x06\x08\x01\x10\x08@H\x98\xb5\x18\x01\"/\n\x12VerifyEmailRequest\x12\x19\n\x05token\x18\x01 \x01(\tB\n\x92\xb5\x18\x02\x08\x01\x98\xb5\x18\x01\"f\n\x11InviteUserRequest\x12\x1a\n\x05\x65mail\x18\x01 \x01(\tB\x0b\x92\xb5\x18\x07\x08\x01\x18\xfe\x01 \x01\x12\x14\n\x04name\x18\x02 \x01(\tB\x06\x92\xb5\x18\x02\x18\x64\x12\x1f\n\x04role\x18\x03 \x01(\tB\x11\x92\xb5\x18\r2\x04user2\x05\x61\x64min\"4\n\x12InviteUserResponse\x12\x1e\n\x04user\x18\x01 \x01(\x0b\x32\x10.v1.UserResponse\"h\n\x13\x41\x63\x63\x65ptInviteRequest\x12\x19\n\x05token\x18\x01 \x01(\tB\n\x92\xb5\x18\x02\x08\x01\x98\xb5\x18\x01\x12\x14\n\x04name\x18\x02 \x01(\tB\x06\x92\xb5\x18\x02\x18\x64\x12 \n\x08password\x18\x03 \x01(\tB\x0e\x92\xb5\x18\x06\x08\x01\x10\x08@H\x98\xb5\x18\x01\x32\x99\x07\n\x0b\x41uthService\x12O\n\x08Register\x12\x13.v1.RegisterRequest\x1a\x10.v1.AuthResponse\"\x1c\x82\xd3\xe4\x93\x02\x16\"\x11/v1/auth/register:\x01*\x12\x46\n\x05Login\x12\x10.v1.LoginRequest\x1a\x10.v1.AuthResponse\"\x19\x82\xd3\xe4\x93\x02\x13\"\x0e/v1/auth/login:\x01*\x12K\n\x06Logout\x12\x11.v1.LogoutRequest\x1a\x12.v1.LogoutResponse\"\x1a\x82\xd3\xe4\x93\x02\x14\"\x0f/v1/auth/logout:\x01*\x12Z\n\x0cRefreshToken\x12\x17.v1.RefreshTokenRequest\x1a\r.v1.TokenPair\"\"\x82\xd3\xe4\x93\x02\x1c\"\x17/v1/auth/refresh-tokens:\x01*\x12\x65\n\x0e\x46orgotPassword\x12\x19.v1.ForgotPasswordRequest\x1a\x13.v1.SuccessResponse\"#\x82\xd3\xe4\x93\x02\x1d\"\x18/v1/auth/forgot-password:\x01*\x12\x62\n\rResetPassword\x12\x18.v1.ResetPasswordRequest\x1a\x13.v1.SuccessResponse\"\"\x82\xd3\xe4\x93\x02\x1c\"\x17/v1/auth/reset-password:\x01*\x12\x64\n\x15SendVerificationEmail\x12\t.v1.Empty\x1a\x13.v1.SuccessResponse\"+\x82\xd3\xe4\x93\x02%\" /v1/auth/send-verification-email:\x01*\x12\\\n\x0bVerifyEmail\x12\x16.v1.VerifyEmailRequest\x1a\x13.v1.SuccessResponse\" \x82\xd3\xe4\x93\x02\x1a\"\x15/v1/auth/verify-email:\x01*\x12[\n\nInviteUser\x12\x15.v1.InviteUserRequest\x1a\x16.v1.InviteUserResponse\"\x1e\x82\xd3\xe4\x93\x02\x14\"\x0f/v1/auth/invite:\x01*\x88\xb5\x18\x01\x12\\\n\x0c\x41\x63\x63\x65ptInvite\x12\x17.v1.AcceptInviteRequest\x1a\x10.v1.AuthResponse\"!\x82\xd3\xe4\x93\x02\x1b\"\x16/v1/auth/accept-invite:\x01*B\'Z%starter-kit-grpc-golang/api/gen/v1;v1b\x06proto3')
 
 _globals = globals()
 _builder.BuildMessageAndEnumDescriptors(DESCRIPTOR, _globals)
@@ -34,6 +35,42 @@ _builder.BuildTopDescriptorsAndMessages(DESCRIPTOR, 'api.proto.v1.auth_pb2', _gl
 if not _descriptor._USE_C_DESCRIPTORS:
   _globals['DESCRIPTOR']._loaded_options = None
   _globals['DESCRIPTOR']._serialized_options = b'Z%starter-kit-grpc-golang/api/gen/v1;v1'
+  _globals['_REGISTERREQUEST'].fields_by_name['name']._loaded_options = None
+  _globals['_REGISTERREQUEST'].fields_by_name['name']._serialized_options = b'\222\265\030\004\010\001\030d'
+  _globals['_REGISTERREQUEST'].fields_by_name['email']._loaded_options = None
+  _globals['_REGISTERREQUEST'].fields_by_name['email']._serialized_options = b'\222\265\030\007\010\001\030\376\001 \001'
+  _globals['_REGISTERREQUEST'].fields_by_name['password']._loaded_options = None
+  _globals['_REGISTERREQUEST'].fields_by_name['password']._serialized_options = b'\222\265\030\006\010\001\020\010@H\230\265\030\001'
+  _globals['_LOGINREQUEST'].fields_by_name['email']._loaded_options = None
+  _globals['_LOGINREQUEST'].fields_by_name['email']._serialized_options = b'\222\265\030\002\010\001'
+  _globals['_LOGINREQUEST'].fields_by_name['password']._loaded_options = None
+  _globals['_LOGINREQUEST'].fields_by_name['password']._serialized_options = b'\222\265\030\002\010\001\230\265\030\001'
+  _globals['_TOKENPAIR_TOKENDETAIL'].fields_by_name['token']._loaded_options = None
+  _globals['_TOKENPAIR_TOKENDETAIL'].fields_by_name['token']._serialized_options = b'\230\265\030\001'
+  _globals['_LOGOUTREQUEST'].fields_by_name['refresh_token']._loaded_options = None
+  _globals['_LOGOUTREQUEST'].fields_by_name['refresh_token']._serialized_options = b'\222\265\030\002\010\001\230\265\030\001'
+  _globals['_REFRESHTOKENREQUEST'].fields_by_name['refresh_token']._loaded_options = None
+  _globals['_REFRESHTOKENREQUEST'].fields_by_name['refresh_token']._serialized_options = b'\222\265\030\002\010\001\230\265\030\001'
+  _globals['_FORGOTPASSWORDREQUEST'].fields_by_name['email']._loaded_options = None
+  _globals['_FORGOTPASSWORDREQUEST'].fields_by_name['email']._serialized_options = b'\222\265\030\007\010\001\030\376\001 \001'
+  _globals['_RESETPASSWORDREQUEST'].fields_by_name['token']._loaded_options = None
+  _globals['_RESETPASSWORDREQUEST'].fields_by_name['token']._serialized_options = b'\222\265\030\002\010\001\230\265\030\001'
+  _globals['_RESETPASSWORDREQUEST'].fields_by_name['password']._loaded_options = None
+  _globals['_RESETPASSWORDREQUEST'].fields_by_name['password']._serialized_options = b'\222\265\030\006\010\001\020\010@H\230\265\030\001'
+  _globals['_VERIFYEMAILREQUEST'].fields_by_name['token']._loaded_options = None
+  _globals['_VERIFYEMAILREQUEST'].fields_by_name['token']._serialized_options = b'\222\265\030\002\010\001\230\265\030\001'
+  _globals['_INVITEUSERREQUEST'].fields_by_name['email']._loaded_options = None
+  _globals['_INVITEUSERREQUEST'].fields_by_name['email']._serialized_options = b'\222\265\030\007\010\001\030\376\001 \001'
+  _globals['_INVITEUSERREQUEST'].fields_by_name['name']._loaded_options = None
+  _globals['_INVITEUSERREQUEST'].fields_by_name['name']._serialized_options = b'\222\265\030\002\030d'
+  _globals['_INVITEUSERREQUEST'].fields_by_name['role']._loaded_options = None
+  _globals['_INVITEUSERREQUEST'].fields_by_name['role']._serialized_options = b'\222\265\030\r2\004user2\005admin'
+  _globals['_ACCEPTINVITEREQUEST'].fields_by_name['token']._loaded_options = None
+  _globals['_ACCEPTINVITEREQUEST'].fields_by_name['token']._serialized_options = b'\222\265\030\002\010\001\230\265\030\001'
+  _globals['_ACCEPTINVITEREQUEST'].fields_by_name['name']._loaded_options = None
+  _globals['_ACCEPTINVITEREQUEST'].fields_by_name['name']._serialized_options = b'\222\265\030\002\030d'
+  _globals['_ACCEPTINVITEREQUEST'].fields_by_name['password']._loaded_options = None
+  _globals['_ACCEPTINVITEREQUEST'].fields_by_name['password']._serialized_options = b'\222\265\030\006\010\001\020\010@H\230\265\030\001'
   _globals['_AUTHSERVICE'].methods_by_name['Register']._loaded_options = None
   _globals['_AUTHSERVICE'].methods_by_name['Register']._serialized_options = b'\202\323\344\223\002\026\"\021/v1/auth/register:\001*'
   _globals['_AUTHSERVICE'].methods_by_name['Login']._loaded_options = None
@@ -50,32 +87,42 @@ if not _descriptor._USE_C_DESCRIPTORS:
   _globals['_AUTHSERVICE'].methods_by_name['SendVerificationEmail']._serialized_options = b'\202\323\344\223\002%\" /v1/auth/send-verification-email:\001*'
   _globals['_AUTHSERVICE'].methods_by_name['VerifyEmail']._loaded_options = None
   _globals['_AUTHSERVICE'].methods_by_name['VerifyEmail']._serialized_options = b'\202\323\344\223\002\032\"\025/v1/auth/verify-email:\001*'
-  _globals['_EMPTY']._serialized_start=86
-  _globals['_EMPTY']._serialized_end=93
-  _globals['_SUCCESSRESPONSE']._serialized_start=95
-  _globals['_SUCCESSRESPONSE']._serialized_end=129
-  _globals['_REGISTERREQUEST']._serialized_start=131
-  _globals['_REGISTERREQUEST']._serialized_end=195
-  _globals['_LOGINREQUEST']._serialized_start=197
-  _globals['_LOGINREQUEST']._serialized_end=244
-  _globals['_TOKENPAIR']._serialized_start=247
-  _globals['_TOKENPAIR']._serialized_end=392
-  _globals['_TOKENPAIR_TOKENDETAIL']._serialized_start=347
-  _globals['_TOKENPAIR_TOKENDETAIL']._serialized_end=392
-  _globals['_AUTHRESPONSE']._serialized_start=394
-  _globals['_AUTHRESPONSE']._serialized_end=471
-  _globals['_LOGOUTREQUEST']._serialized_start=473
-  _globals['_LOGOUTREQUEST']._serialized_end=511
-  _globals['_LOGOUTRESPONSE']._serialized_start=513
-  _globals['_LOGOUTRESPONSE']._serialized_end=546
-  _globals['_REFRESHTOKENREQUEST']._serialized_start=548
-  _globals['_REFRESHTOKENREQUEST']._serialized_end=592
-  _globals['_FORGOTPASSWORDREQUEST']._serialized_start=594
-  _globals['_FORGOTPASSWORDREQUEST']._serialized_end=632
-  _globals['_RESETPASSWORDREQUEST']._serialized_start=634
-  _globals['_RESETPASSWORDREQUEST']._serialized_end=689
-  _globals['_VERIFYEMAILREQUEST']._serialized_start=691
-  _globals['_VERIFYEMAILREQUEST']._serialized_end=726
-  _globals['_AUTHSERVICE']._serialized_start=729
-  _globals['_AUTHSERVICE']._serialized_end=1463
+  _globals['_AUTHSERVICE'].methods_by_name['InviteUser']._loaded_options = None
+  _globals['_AUTHSERVICE'].methods_by_name['InviteUser']._serialized_options = b'\202\323\344\223\002\024\"\017/v1/auth/invite:\001*\210\265\030\001'
+  _globals['_AUTHSERVICE'].methods_by_name['AcceptInvite']._loaded_options = None
+  _globals['_AUTHSERVICE'].methods_by_name['AcceptInvite']._serialized_options = b'\202\323\344\223\002\033\"\026/v1/auth/accept-invite:\001*'
+  _globals['_EMPTY']._serialized_start=114
+  _globals['_EMPTY']._serialized_end=121
+  _globals['_SUCCESSRESPONSE']._serialized_start=123
+  _globals['_SUCCESSRESPONSE']._serialized_end=157
+  _globals['_REGISTERREQUEST']._serialized_start=159
+  _globals['_REGISTERREQUEST']._serialized_end=262
+  _globals['_LOGINREQUEST']._serialized_start=264
+  _globals['_LOGINREQUEST']._serialized_end=331
+  _globals['_TOKENPAIR']._serialized_start=334
+  _globals['_TOKENPAIR']._serialized_end=485
+  _globals['_TOKENPAIR_TOKENDETAIL']._serialized_start=434
+  _globals['_TOKENPAIR_TOKENDETAIL']._serialized_end=485
+  _globals['_AUTHRESPONSE']._serialized_start=487
+  _globals['_AUTHRESPONSE']._serialized_end=564
+  _globals['_LOGOUTREQUEST']._serialized_start=566
+  _globals['_LOGOUTREQUEST']._serialized_end=616
+  _globals['_LOGOUTRESPONSE']._serialized_start=618
+  _globals['_LOGOUTRESPONSE']._serialized_end=651
+  _globals['_REFRESHTOKENREQUEST']._serialized_start=653
+  _globals['_REFRESHTOKENREQUEST']._serialized_end=709
+  _globals['_FORGOTPASSWORDREQUEST']._serialized_start=711
+  _globals['_FORGOTPASSWORDREQUEST']._serialized_end=762
+  _globals['_RESETPASSWORDREQUEST']._serialized_start=764
+  _globals['_RESETPASSWORDREQUEST']._serialized_end=847
+  _globals['_VERIFYEMAILREQUEST']._serialized_start=849
+  _globals['_VERIFYEMAILREQUEST']._serialized_end=896
+  _globals['_INVITEUSERREQUEST']._serialized_start=898
+  _globals['_INVITEUSERREQUEST']._serialized_end=1000
+  _globals['_INVITEUSERRESPONSE']._serialized_start=1002
+  _globals['_INVITEUSERRESPONSE']._serialized_end=1054
+  _globals['_ACCEPTINVITEREQUEST']._serialized_start=1056
+  _globals['_ACCEPTINVITEREQUEST']._serialized_end=1160
+  _globals['_AUTHSERVICE']._serialized_start=1163
+  _globals['_AUTHSERVICE']._serialized_end=2084
 # @@protoc_insertion_point(module_scope)
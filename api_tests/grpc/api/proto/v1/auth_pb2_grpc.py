@@ -74,6 +74,16 @@ class AuthServiceStub(object):
                 request_serializer=api_dot_proto_dot_v1_dot_auth__pb2.VerifyEmailRequest.SerializeToString,
                 response_deserializer=api_dot_proto_dot_v1_dot_auth__pb2.SuccessResponse.FromString,
                 _registered_method=True)
+        self.InviteUser = channel.unary_unary(
+                '/v1.AuthService/InviteUser',
+                request_serializer=api_dot_proto_dot_v1_dot_auth__pb2.InviteUserRequest.SerializeToString,
+                response_deserializer=api_dot_proto_dot_v1_dot_auth__pb2.InviteUserResponse.FromString,
+                _registered_method=True)
+        self.AcceptInvite = channel.unary_unary(
+                '/v1.AuthService/AcceptInvite',
+                request_serializer=api_dot_proto_dot_v1_dot_auth__pb2.AcceptInviteRequest.SerializeToString,
+                response_deserializer=api_dot_proto_dot_v1_dot_auth__pb2.AuthResponse.FromString,
+                _registered_method=True)
 
 
 class AuthServiceServicer(object):
@@ -129,7 +139,21 @@ class AuthServiceServicer(object):
         raise NotImplementedError('Method not implemented!')
 
     def VerifyEmail(self, request, context):
-        """Verify Email (Use token from email)
+        """Verify Email (Use token from email). Refresh the tokens afterwards to pick up the verified claim.
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def InviteUser(self, request, context):
+        """Invite User (Admin only - sends invitation email)
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def AcceptInvite(self, request, context):
+        """Accept Invite (Use token from email, sets name/password)
         """
         context.set_code(grpc.StatusCode.UNIMPLEMENTED)
         context.set_details('Method not implemented!')
@@ -178,6 +202,16 @@ def add_AuthServiceServicer_to_server(servicer, server):
                     request_deserializer=api_dot_proto_dot_v1_dot_auth__pb2.VerifyEmailRequest.FromString,
                     response_serializer=api_dot_proto_dot_v1_dot_auth__pb2.SuccessResponse.SerializeToString,
             ),
+            'InviteUser': grpc.unary_unary_rpc_method_handler(
+                    servicer.InviteUser,
+                    request_deserializer=api_dot_proto_dot_v1_dot_auth__pb2.InviteUserRequest.FromString,
+                    response_serializer=api_dot_proto_dot_v1_dot_auth__pb2.InviteUserResponse.SerializeToString,
+            ),
+            'AcceptInvite': grpc.unary_unary_rpc_method_handler(
+                    servicer.AcceptInvite,
+                    request_deserializer=api_dot_proto_dot_v1_dot_auth__pb2.AcceptInviteRequest.FromString,
+                    response_serializer=api_dot_proto_dot_v1_dot_auth__pb2.AuthResponse.SerializeToString,
+            ),
     }
     generic_handler = grpc.method_handlers_generic_handler(
             'v1.AuthService', rpc_method_handlers)
@@ -404,3 +438,57 @@ class AuthService(object):
             timeout,
             metadata,
             _registered_method=True)
+
+    @staticmethod
+    def InviteUser(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/v1.AuthService/InviteUser',
+            api_dot_proto_dot_v1_dot_auth__pb2.InviteUserRequest.SerializeToString,
+            api_dot_proto_dot_v1_dot_auth__pb2.InviteUserResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def AcceptInvite(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/v1.AuthService/AcceptInvite',
+            api_dot_proto_dot_v1_dot_auth__pb2.AcceptInviteRequest.SerializeToString,
+            api_dot_proto_dot_v1_dot_auth__pb2.AuthResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
@@ -25,7 +25,7 @@ _sym_db = _symbol_database.Default()
 from google.api import annotations_pb2 as google_dot_api_dot_annotations__pb2
 
 
-DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n\x19\x61pi/proto/v1/health.proto\x12\x02v1\x1a\x1cgoogle/api/annotations.proto\"\x14\n\x12HealthCheckRequest\"6\n\x13HealthCheckResponse\x12\x0e\n\x06status\x18\x01 \x01(\t\x12\x0f\n\x07message\x18\x02 \x01(\t2c\n\rHealthService\x12R\n\x0bHealthCheck\x12\x16.v1.HealthCheckRequest\x1a\x17.v1.HealthCheckResponse\"\x12\x82\xd3\xe4\x93\x02\x0c\x12\n/v1/healthB\'Z%starter-kit-grpc-golang/api/gen/v1;v1b\x06proto3')
+DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n\x19\x61pi/proto/v1/health.proto\x12\x02v1\x1a\x1cgoogle/api/annotations.proto\"\x14\n\x12HealthCheckRequest\"\x9a\x01\n\x13HealthCheckResponse\x12\x0e\n\x06status\x18\x01 \x01(\t\x12\x0f\n\x07message\x18\x02 \x01(\t\x12\x33\n\x06\x63hecks\x18\x03 \x03(\x0b\x32#.v1.HealthCheckResponse.ChecksEntry\x1a-\n\x0b\x43hecksEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\x32\x63\n\rHealthService\x12R\n\x0bHealthCheck\x12\x16.v1.HealthCheckRequest\x1a\x17.v1.HealthCheckResponse\"\x12\x82\xd3\xe4\x93\x02\x0c\x12\n/v1/healthB\'Z%starter-kit-grpc-golang/api/gen/v1;v1b\x06proto3')
 
 _globals = globals()
 _builder.BuildMessageAndEnumDescriptors(DESCRIPTOR, _globals)
@@ -33,12 +33,16 @@ _builder.BuildTopDescriptorsAndMessages(DESCRIPTOR, 'api.proto.v1.health_pb2', _
 if not _descriptor._USE_C_DESCRIPTORS:
   _globals['DESCRIPTOR']._loaded_options = None
   _globals['DESCRIPTOR']._serialized_options = b'Z%starter-kit-grpc-golang/api/gen/v1;v1'
+  _globals['_HEALTHCHECKRESPONSE_CHECKSENTRY']._loaded_options = None
+  _globals['_HEALTHCHECKRESPONSE_CHECKSENTRY']._serialized_options = b'8\001'
   _globals['_HEALTHSERVICE'].methods_by_name['HealthCheck']._loaded_options = None
   _globals['_HEALTHSERVICE'].methods_by_name['HealthCheck']._serialized_options = b'\202\323\344\223\002\014\022\n/v1/health'
   _globals['_HEALTHCHECKREQUEST']._serialized_start=63
   _globals['_HEALTHCHECKREQUEST']._serialized_end=83
-  _globals['_HEALTHCHECKRESPONSE']._serialized_start=85
-  _globals['_HEALTHCHECKRESPONSE']._serialized_end=139
-  _globals['_HEALTHSERVICE']._serialized_start=141
-  _globals['_HEALTHSERVICE']._serialized_end=240
+  _globals['_HEALTHCHECKRESPONSE']._serialized_start=86
+  _globals['_HEALTHCHECKRESPONSE']._serialized_end=240
+  _globals['_HEALTHCHECKRESPONSE_CHECKSENTRY']._serialized_start=195
+  _globals['_HEALTHCHECKRESPONSE_CHECKSENTRY']._serialized_end=240
+  _globals['_HEALTHSERVICE']._serialized_start=242
+  _globals['_HEALTHSERVICE']._serialized_end=341
 # @@protoc_insertion_point(module_scope)
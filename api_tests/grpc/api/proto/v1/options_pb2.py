@@ -0,0 +1,38 @@
+# -*- coding: utf-8 -*-
+# Generated by the protocol buffer compiler.  DO NOT EDIT!
+# NO CHECKED-IN PROTOBUF GENCODE
+# source: api/proto/v1/options.proto
+# Protobuf Python Version: 6.31.1
+"""Generated protocol buffer code."""
+from google.protobuf import descriptor as _descriptor
+from google.protobuf import descriptor_pool as _descriptor_pool
+from google.protobuf import runtime_version as _runtime_version
+from google.protobuf import symbol_database as _symbol_database
+from google.protobuf.internal import builder as _builder
+_runtime_version.ValidateProtobufRuntimeVersion(
+    _runtime_version.Domain.PUBLIC,
+    6,
+    31,
+    1,
+    '',
+    'api/proto/v1/options.proto'
+)
+# @@protoc_insertion_point(imports)
+
+_sym_db = _symbol_database.Default()
+
+
+from google.protobuf import descriptor_pb2 as google_dot_protobuf_dot_descriptor__pb2
+
+
+DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n\x1a\x61pi/proto/v1/options.proto\x12\x02v1\x1a google/protobuf/descriptor.proto\"\x8f\x01\n\nFieldRules\x12\x10\n\x08required\x18\x01 \x01(\x08\x12\x0f\n\x07min_len\x18\x02 \x01(\r\x12\x0f\n\x07max_len\x18\x03 \x01(\r\x12\r\n\x05\x65mail\x18\x04 \x01(\x08\x12\x0c\n\x04uuid\x18\x05 \x01(\x08\x12\n\n\x02in\x18\x06 \x03(\t\x12\x11\n\tmax_items\x18\x07 \x01(\r\x12\x11\n\tmax_bytes\x18\x08 \x01(\r:A\n\x17requires_verified_email\x12\x1e.google.protobuf.MethodOptions\x18\xd1\x86\x03 \x01(\x08:>\n\x05rules\x12\x1d.google.protobuf.FieldOptions\x18\xd2\x86\x03 \x01(\x0b\x32\x0e.v1.FieldRules:2\n\tsensitive\x12\x1d.google.protobuf.FieldOptions\x18\xd3\x86\x03 \x01(\x08\x42\'Z%starter-kit-grpc-golang/api/gen/v1;v1b\x06proto3')
+
+_globals = globals()
+_builder.BuildMessageAndEnumDescriptors(DESCRIPTOR, _globals)
+_builder.BuildTopDescriptorsAndMessages(DESCRIPTOR, 'api.proto.v1.options_pb2', _globals)
+if not _descriptor._USE_C_DESCRIPTORS:
+  _globals['DESCRIPTOR']._loaded_options = None
+  _globals['DESCRIPTOR']._serialized_options = b'Z%starter-kit-grpc-golang/api/gen/v1;v1'
+  _globals['_FIELDRULES']._serialized_start=69
+  _globals['_FIELDRULES']._serialized_end=212
+# @@protoc_insertion_point(module_scope)
@@ -23,10 +23,14 @@ _sym_db = _symbol_database.Default()
 
 
 from google.api import annotations_pb2 as google_dot_api_dot_annotations__pb2
+from google.longrunning import operations_pb2 as google_dot_longrunning_dot_operations__pb2
+from google.protobuf import field_mask_pb2 as google_dot_protobuf_dot_field__mask__pb2
 from google.protobuf import timestamp_pb2 as google_dot_protobuf_dot_timestamp__pb2
+from google.rpc import status_pb2 as google_dot_rpc_dot_status__pb2
+from api.proto.v1 import options_pb2 as api_dot_proto_dot_v1_dot_options__pb2
 
 
-DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n\x17\x61pi/proto/v1/user.proto\x12\x02v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xc0\x01\n\x0cUserResponse\x12\n\n\x02id\x18\x01 \x01(\t\x12\x0c\n\x04name\x18\x02 \x01(\t\x12\r\n\x05\x65mail\x18\x03 \x01(\t\x12\x0c\n\x04role\x18\x04 \x01(\t\x12\x19\n\x11is_email_verified\x18\x05 \x01(\x08\x12.\n\ncreated_at\x18\x06 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12.\n\nupdated_at\x18\x07 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\"P\n\x11\x43reateUserRequest\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\r\n\x05\x65mail\x18\x02 \x01(\t\x12\x10\n\x08password\x18\x03 \x01(\t\x12\x0c\n\x04role\x18\x04 \x01(\t\"\x1c\n\x0eGetUserRequest\x12\n\n\x02id\x18\x01 \x01(\t\"j\n\x10ListUsersRequest\x12\x0c\n\x04page\x18\x01 \x01(\x05\x12\r\n\x05limit\x18\x02 \x01(\x05\x12\x0c\n\x04sort\x18\x03 \x01(\t\x12\x0e\n\x06search\x18\x04 \x01(\t\x12\x0c\n\x04role\x18\x05 \x01(\t\x12\r\n\x05scope\x18\x06 \x01(\t\"\x7f\n\x11ListUsersResponse\x12!\n\x07results\x18\x01 \x03(\x0b\x32\x10.v1.UserResponse\x12\x0c\n\x04page\x18\x02 \x01(\x05\x12\r\n\x05limit\x18\x03 \x01(\x05\x12\x13\n\x0btotal_pages\x18\x04 \x01(\x05\x12\x15\n\rtotal_results\x18\x05 \x01(\x03\"N\n\x11UpdateUserRequest\x12\n\n\x02id\x18\x01 \x01(\t\x12\x0c\n\x04name\x18\x02 \x01(\t\x12\r\n\x05\x65mail\x18\x03 \x01(\t\x12\x10\n\x08password\x18\x04 \x01(\t\"\x1f\n\x11\x44\x65leteUserRequest\x12\n\n\x02id\x18\x01 \x01(\t\"%\n\x12\x44\x65leteUserResponse\x12\x0f\n\x07success\x18\x01 \x01(\x08\x32\x97\x03\n\x0bUserService\x12K\n\nCreateUser\x12\x15.v1.CreateUserRequest\x1a\x10.v1.UserResponse\"\x14\x82\xd3\xe4\x93\x02\x0e\"\t/v1/users:\x01*\x12G\n\x07GetUser\x12\x12.v1.GetUserRequest\x1a\x10.v1.UserResponse\"\x16\x82\xd3\xe4\x93\x02\x10\x12\x0e/v1/users/{id}\x12K\n\tListUsers\x12\x14.v1.ListUsersRequest\x1a\x15.v1.ListUsersResponse\"\x11\x82\xd3\xe4\x93\x02\x0b\x12\t/v1/users\x12P\n\nUpdateUser\x12\x15.v1.UpdateUserRequest\x1a\x10.v1.UserResponse\"\x19\x82\xd3\xe4\x93\x02\x13\x32\x0e/v1/users/{id}:\x01*\x12S\n\nDeleteUser\x12\x15.v1.DeleteUserRequest\x1a\x16.v1.DeleteUserResponse\"\x16\x82\xd3\xe4\x93\x02\x10*\x0e/v1/users/{id}B\'Z%starter-kit-grpc-golang/api/gen/v1;v1b\x06proto3')
+DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n\x17\x61pi/proto/v1/user.proto\x12\x02v1\x1a\x1cgoogle/api/annotations.proto\x1a#google/longrunning/operations.proto\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x17google/rpc/status.proto\x1a\x1a\x61pi/proto/v1/options.proto\"\xa5\x02\n\x0cUserResponse\x12\n\n\x02id\x18\x01 \x01(\t\x12\x0c\n\x04name\x18\x02 \x01(\t\x12\r\n\x05\x65mail\x18\x03 \x01(\t\x12\x0c\n\x04role\x18\x04 \x01(\t\x12\x19\n\x11is_email_verified\x18\x05 \x01(\x08\x12.\n\ncreated_at\x18\x06 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12.\n\nupdated_at\x18\x07 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x0e\n\x06status\x18\x08 \x01(\t\x12\x15\n\rstatus_reason\x18\t \x01(\t\x12.\n\ndeleted_at\x18\n \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x0c\n\x04\x65tag\x18\x0b \x01(\t\"\x8a\x01\n\x11\x43reateUserRequest\x12\x16\n\x04name\x18\x01 \x01(\tB\x08\x92\xb5\x18\x04\x08\x01\x18\x64\x12\x1a\n\x05\x65mail\x18\x02 \x01(\tB\x0b\x92\xb5\x18\x07\x08\x01\x18\xfe\x01 \x01\x12 \n\x08password\x18\x03 \x01(\tB\x0e\x92\xb5\x18\x06\x08\x01\x10\x08@H\x98\xb5\x18\x01\x12\x1f\n\x04role\x18\x04 \x01(\tB\x11\x92\xb5\x18\r2\x04user2\x05\x61\x64min\"&\n\x0eGetUserRequest\x12\x14\n\x02id\x18\x01 \x01(\tB\x08\x92\xb5\x18\x04\x08\x01(\x01\"\xf8\x01\n\x10ListUsersRequest\x12\x0c\n\x04page\x18\x01 \x01(\x05\x12\r\n\x05limit\x18\x02 \x01(\x05\x12\x0c\n\x04sort\x18\x03 \x01(\t\x12\x17\n\x06search\x18\x04 \x01(\tB\x07\x92\xb5\x18\x03\x18\x80\x02\x12\x1f\n\x04role\x18\x05 \x01(\tB\x11\x92\xb5\x18\r2\x04user2\x05\x61\x64min\x12)\n\x05scope\x18\x06 \x01(\tB\x1a\x92\xb5\x18\x16\x32\x04name2\x05\x65mail2\x02id2\x03\x61ll\x12\x12\n\npage_token\x18\x07 \x01(\t\x12\x15\n\rinclude_total\x18\x08 \x01(\x08\x12\x17\n\x06\x66ilter\x18\t \x01(\tB\x07\x92\xb5\x18\x03\x18\x80\x08\x12\x10\n\x08order_by\x18\n \x01(\t\"\x98\x01\n\x11ListUsersResponse\x12!\n\x07results\x18\x01 \x03(\x0b\x32\x10.v1.UserResponse\x12\x0c\n\x04page\x18\x02 \x01(\x05\x12\r\n\x05limit\x18\x03 \x01(\x05\x12\x13\n\x0btotal_pages\x18\x04 \x01(\x05\x12\x15\n\rtotal_results\x18\x05 \x01(\x03\x12\x17\n\x0fnext_page_token\x18\x06 \x01(\t\"B\n\x14\x42\x61tchGetUsersRequest\x12\x13\n\x03ids\x18\x01 \x03(\tB\x06\x92\xb5\x18\x02\x08\x01\x12\x15\n\rallow_partial\x18\x02 \x01(\x08\"a\n\x17\x42\x61tchCreateUsersRequest\x12/\n\x08requests\x18\x01 \x03(\x0b\x32\x15.v1.CreateUserRequestB\x06\x92\xb5\x18\x02\x08\x01\x12\x15\n\rallow_partial\x18\x02 \x01(\x08\"E\n\x17\x42\x61tchDeleteUsersRequest\x12\x13\n\x03ids\x18\x01 \x03(\tB\x06\x92\xb5\x18\x02\x08\x01\x12\x15\n\rallow_partial\x18\x02 \x01(\x08\"U\n\x0f\x42\x61tchUserResult\x12\x1e\n\x04user\x18\x01 \x01(\x0b\x32\x10.v1.UserResponse\x12\"\n\x06status\x18\x02 \x01(\x0b\x32\x12.google.rpc.Status\":\n\x12\x42\x61tchUsersResponse\x12$\n\x07results\x18\x01 \x03(\x0b\x32\x13.v1.BatchUserResult\"l\n\x12ImportUsersRequest\x12#\n\x06\x66ormat\x18\x01 \x01(\tB\x13\x92\xb5\x18\x0f\x08\x01\x32\x03\x63sv2\x06ndjson\x12\x1b\n\x07\x63ontent\x18\x02 \x01(\x0c\x42\n\x92\xb5\x18\x02\x08\x01\x98\xb5\x18\x01\x12\x14\n\x0csend_invites\x18\x03 \x01(\x08\"\xa3\x01\n\x13ImportUsersMetadata\x12\x12\n\ntotal_rows\x18\x01 \x01(\x05\x12\x16\n\x0eprocessed_rows\x18\x02 \x01(\x05\x12/\n\x0b\x63reate_time\x18\x03 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12/\n\x0bupdate_time\x18\x04 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\"}\n\x13ImportUsersResponse\x12\x15\n\rcreated_count\x18\x01 \x01(\x05\x12\x15\n\rinvited_count\x18\x02 \x01(\x05\x12\x14\n\x0c\x66\x61iled_count\x18\x03 \x01(\x05\x12\"\n\x06\x65rrors\x18\x04 \x03(\x0b\x32\x12.v1.ImportRowError\"=\n\x0eImportRowError\x12\x0b\n\x03row\x18\x01 \x01(\x05\x12\r\n\x05\x65mail\x18\x02 \x01(\t\x12\x0f\n\x07message\x18\x03 \x01(\t\"R\n\x12\x45xportUsersRequest\x12#\n\x06\x66ormat\x18\x01 \x01(\tB\x13\x92\xb5\x18\x0f\x08\x01\x32\x03\x63sv2\x06ndjson\x12\x17\n\x06\x66ilter\x18\x02 \x01(\tB\x07\x92\xb5\x18\x03\x18\x80\x08\"\x8e\x01\n\x13\x45xportUsersMetadata\x12\x15\n\rexported_rows\x18\x01 \x01(\x05\x12/\n\x0b\x63reate_time\x18\x02 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12/\n\x0bupdate_time\x18\x03 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\"P\n\x13\x45xportUsersResponse\x12\x0e\n\x06\x66ormat\x18\x01 \x01(\t\x12\x12\n\nuser_count\x18\x02 \x01(\x05\x12\x15\n\x07\x63ontent\x18\x03 \x01(\x0c\x42\x04\x98\xb5\x18\x01\")\n\x11WatchUsersRequest\x12\x14\n\x0cresume_token\x18\x01 \x01(\t\"\x80\x01\n\tUserEvent\x12\x0c\n\x04type\x18\x01 \x01(\t\x12\x1e\n\x04user\x18\x02 \x01(\x0b\x32\x10.v1.UserResponse\x12/\n\x0boccurred_at\x18\x03 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x14\n\x0cresume_token\x18\x04 \x01(\t\"\\\n\nUserUpdate\x12\x14\n\x04name\x18\x01 \x01(\tB\x06\x92\xb5\x18\x02\x18\x64\x12\x18\n\x05\x65mail\x18\x02 \x01(\tB\t\x92\xb5\x18\x05\x18\xfe\x01 \x01\x12\x1e\n\x08password\x18\x03 \x01(\tB\x0c\x92\xb5\x18\x04\x10\x08@H\x98\xb5\x18\x01\"\xdc\x01\n\x11UpdateUserRequest\x12\x14\n\x02id\x18\x01 \x01(\tB\x08\x92\xb5\x18\x04\x08\x01(\x01\x12\x16\n\x04name\x18\x02 \x01(\tB\x08\x18\x01\x92\xb5\x18\x02\x18\x64\x12\x1a\n\x05\x65mail\x18\x03 \x01(\tB\x0b\x18\x01\x92\xb5\x18\x05\x18\xfe\x01 \x01\x12 \n\x08password\x18\x04 \x01(\tB\x0e\x18\x01\x92\xb5\x18\x04\x10\x08@H\x98\xb5\x18\x01\x12\x1c\n\x04user\x18\x05 \x01(\x0b\x32\x0e.v1.UserUpdate\x12/\n\x0bupdate_mask\x18\x06 \x01(\x0b\x32\x1a.google.protobuf.FieldMask\x12\x0c\n\x04\x65tag\x18\x07 \x01(\t\"7\n\x11\x44\x65leteUserRequest\x12\x14\n\x02id\x18\x01 \x01(\tB\x08\x92\xb5\x18\x04\x08\x01(\x01\x12\x0c\n\x04\x65tag\x18\x02 \x01(\t\"%\n\x12\x44\x65leteUserResponse\x12\x0f\n\x07success\x18\x01 \x01(\x08\"+\n\x13UndeleteUserRequest\x12\x14\n\x02id\x18\x01 \x01(\tB\x08\x92\xb5\x18\x04\x08\x01(\x01\"H\n\x17\x43hangeUserStatusRequest\x12\x14\n\x02id\x18\x01 \x01(\tB\x08\x92\xb5\x18\x04\x08\x01(\x01\x12\x17\n\x06reason\x18\x02 \x01(\tB\x07\x92\xb5\x18\x03\x18\xf4\x03\x32\xae\x0c\n\x0bUserService\x12K\n\nCreateUser\x12\x15.v1.CreateUserRequest\x1a\x10.v1.UserResponse\"\x14\x82\xd3\xe4\x93\x02\x0e\"\t/v1/users:\x01*\x12G\n\x07GetUser\x12\x12.v1.GetUserRequest\x1a\x10.v1.UserResponse\"\x16\x82\xd3\xe4\x93\x02\x10\x12\x0e/v1/users/{id}\x12K\n\tListUsers\x12\x14.v1.ListUsersRequest\x1a\x15.v1.ListUsersResponse\"\x11\x82\xd3\xe4\x93\x02\x0b\x12\t/v1/users\x12]\n\rBatchGetUsers\x12\x18.v1.BatchGetUsersRequest\x1a\x16.v1.BatchUsersResponse\"\x1a\x82\xd3\xe4\x93\x02\x14\x12\x12/v1/users:batchGet\x12i\n\x10\x42\x61tchCreateUsers\x12\x1b.v1.BatchCreateUsersRequest\x1a\x16.v1.BatchUsersResponse\" \x82\xd3\xe4\x93\x02\x1a\"\x15/v1/users:batchCreate:\x01*\x12i\n\x10\x42\x61tchDeleteUsers\x12\x1b.v1.BatchDeleteUsersRequest\x1a\x16.v1.BatchUsersResponse\" \x82\xd3\xe4\x93\x02\x1a\"\x15/v1/users:batchDelete:\x01*\x12\x8e\x01\n\x0bImportUsers\x12\x16.v1.ImportUsersRequest\x1a\x1d.google.longrunning.Operation\"H\x82\xd3\xe4\x93\x02\x15\"\x10/v1/users:import:\x01*\xca\x41*\n\x13ImportUsersResponse\x12\x13ImportUsersMetadata\x12\x8e\x01\n\x0b\x45xportUsers\x12\x16.v1.ExportUsersRequest\x1a\x1d.google.longrunning.Operation\"H\x82\xd3\xe4\x93\x02\x15\"\x10/v1/users:export:\x01*\xca\x41*\n\x13\x45xportUsersResponse\x12\x13\x45xportUsersMetadata\x12M\n\nWatchUsers\x12\x15.v1.WatchUsersRequest\x1a\r.v1.UserEvent\"\x17\x82\xd3\xe4\x93\x02\x11\x12\x0f/v1/users:watch0\x01\x12S\n\nUpdateUser\x12\x15.v1.UpdateUserRequest\x1a\x10.v1.UserResponse\"\x1c\x82\xd3\xe4\x93\x02\x16\x32\x0e/v1/users/{id}:\x04user\x12S\n\nDeleteUser\x12\x15.v1.DeleteUserRequest\x1a\x16.v1.DeleteUserResponse\"\x16\x82\xd3\xe4\x93\x02\x10*\x0e/v1/users/{id}\x12]\n\x0cUndeleteUser\x12\x17.v1.UndeleteUserRequest\x1a\x10.v1.UserResponse\"\"\x82\xd3\xe4\x93\x02\x1c\"\x17/v1/users/{id}:undelete:\x01*\x12^\n\x10ListDeletedUsers\x12\x14.v1.ListUsersRequest\x1a\x15.v1.ListUsersResponse\"\x1d\x82\xd3\xe4\x93\x02\x17\x12\x15/v1/users:listDeleted\x12_\n\x0bSuspendUser\x12\x1b.v1.ChangeUserStatusRequest\x1a\x10.v1.UserResponse\"!\x82\xd3\xe4\x93\x02\x1b\"\x16/v1/users/{id}:suspend:\x01*\x12\x65\n\x0e\x44\x65\x61\x63tivateUser\x12\x1b.v1.ChangeUserStatusRequest\x1a\x10.v1.UserResponse\"$\x82\xd3\xe4\x93\x02\x1e\"\x19/v1/users/{id}:deactivate:\x01*\x12\x65\n\x0eReactivateUser\x12\x1b.v1.ChangeUserStatusRequest\x1a\x10.v1.UserResponse\"$\x82\xd3\xe4\x93\x02\x1e\"\x19/v1/users/{id}:reactivate:\x01*B\'Z%starter-kit-grpc-golang/api/gen/v1;v1b\x06proto3')
 
 _globals = globals()
 _builder.BuildMessageAndEnumDescriptors(DESCRIPTOR, _globals)
@@ -34,32 +38,144 @@ _builder.BuildTopDescriptorsAndMessages(DESCRIPTOR, 'api.proto.v1.user_pb2', _gl
 if not _descriptor._USE_C_DESCRIPTORS:
   _globals['DESCRIPTOR']._loaded_options = None
   _globals['DESCRIPTOR']._serialized_options = b'Z%starter-kit-grpc-golang/api/gen/v1;v1'
+  _globals['_CREATEUSERREQUEST'].fields_by_name['name']._loaded_options = None
+  _globals['_CREATEUSERREQUEST'].fields_by_name['name']._serialized_options = b'\222\265\030\004\010\001\030d'
+  _globals['_CREATEUSERREQUEST'].fields_by_name['email']._loaded_options = None
+  _globals['_CREATEUSERREQUEST'].fields_by_name['email']._serialized_options = b'\222\265\030\007\010\001\030\376\001 \001'
+  _globals['_CREATEUSERREQUEST'].fields_by_name['password']._loaded_options = None
+  _globals['_CREATEUSERREQUEST'].fields_by_name['password']._serialized_options = b'\222\265\030\006\010\001\020\010@H\230\265\030\001'
+  _globals['_CREATEUSERREQUEST'].fields_by_name['role']._loaded_options = None
+  _globals['_CREATEUSERREQUEST'].fields_by_name['role']._serialized_options = b'\222\265\030\r2\004user2\005admin'
+  _globals['_GETUSERREQUEST'].fields_by_name['id']._loaded_options = None
+  _globals['_GETUSERREQUEST'].fields_by_name['id']._serialized_options = b'\222\265\030\004\010\001(\001'
+  _globals['_LISTUSERSREQUEST'].fields_by_name['search']._loaded_options = None
+  _globals['_LISTUSERSREQUEST'].fields_by_name['search']._serialized_options = b'\222\265\030\003\030\200\002'
+  _globals['_LISTUSERSREQUEST'].fields_by_name['role']._loaded_options = None
+  _globals['_LISTUSERSREQUEST'].fields_by_name['role']._serialized_options = b'\222\265\030\r2\004user2\005admin'
+  _globals['_LISTUSERSREQUEST'].fields_by_name['scope']._loaded_options = None
+  _globals['_LISTUSERSREQUEST'].fields_by_name['scope']._serialized_options = b'\222\265\030\0262\004name2\005email2\002id2\003all'
+  _globals['_LISTUSERSREQUEST'].fields_by_name['filter']._loaded_options = None
+  _globals['_LISTUSERSREQUEST'].fields_by_name['filter']._serialized_options = b'\222\265\030\003\030\200\010'
+  _globals['_BATCHGETUSERSREQUEST'].fields_by_name['ids']._loaded_options = None
+  _globals['_BATCHGETUSERSREQUEST'].fields_by_name['ids']._serialized_options = b'\222\265\030\002\010\001'
+  _globals['_BATCHCREATEUSERSREQUEST'].fields_by_name['requests']._loaded_options = None
+  _globals['_BATCHCREATEUSERSREQUEST'].fields_by_name['requests']._serialized_options = b'\222\265\030\002\010\001'
+  _globals['_BATCHDELETEUSERSREQUEST'].fields_by_name['ids']._loaded_options = None
+  _globals['_BATCHDELETEUSERSREQUEST'].fields_by_name['ids']._serialized_options = b'\222\265\030\002\010\001'
+  _globals['_IMPORTUSERSREQUEST'].fields_by_name['format']._loaded_options = None
+  _globals['_IMPORTUSERSREQUEST'].fields_by_name['format']._serialized_options = b'\222\265\030\017\010\0012\003csv2\006ndjson'
+  _globals['_IMPORTUSERSREQUEST'].fields_by_name['content']._loaded_options = None
+  _globals['_IMPORTUSERSREQUEST'].fields_by_name['content']._serialized_options = b'\222\265\030\002\010\001\230\265\030\001'
+  _globals['_EXPORTUSERSREQUEST'].fields_by_name['format']._loaded_options = None
+  _globals['_EXPORTUSERSREQUEST'].fields_by_name['format']._serialized_options = b'\222\265\030\017\010\0012\003csv2\006ndjson'
+  _globals['_EXPORTUSERSREQUEST'].fields_by_name['filter']._loaded_options = None
+  _globals['_EXPORTUSERSREQUEST'].fields_by_name['filter']._serialized_options = b'\222\265\030\003\030\200\010'
+  _globals['_EXPORTUSERSRESPONSE'].fields_by_name['content']._loaded_options = None
+  _globals['_EXPORTUSERSRESPONSE'].fields_by_name['content']._serialized_options = b'\230\265\030\001'
+  _globals['_USERUPDATE'].fields_by_name['name']._loaded_options = None
+  _globals['_USERUPDATE'].fields_by_name['name']._serialized_options = b'\222\265\030\002\030d'
+  _globals['_USERUPDATE'].fields_by_name['email']._loaded_options = None
+  _globals['_USERUPDATE'].fields_by_name['email']._serialized_options = b'\222\265\030\005\030\376\001 \001'
+  _globals['_USERUPDATE'].fields_by_name['password']._loaded_options = None
+  _globals['_USERUPDATE'].fields_by_name['password']._serialized_options = b'\222\265\030\004\020\010@H\230\265\030\001'
+  _globals['_UPDATEUSERREQUEST'].fields_by_name['id']._loaded_options = None
+  _globals['_UPDATEUSERREQUEST'].fields_by_name['id']._serialized_options = b'\222\265\030\004\010\001(\001'
+  _globals['_UPDATEUSERREQUEST'].fields_by_name['name']._loaded_options = None
+  _globals['_UPDATEUSERREQUEST'].fields_by_name['name']._serialized_options = b'\030\001\222\265\030\002\030d'
+  _globals['_UPDATEUSERREQUEST'].fields_by_name['email']._loaded_options = None
+  _globals['_UPDATEUSERREQUEST'].fields_by_name['email']._serialized_options = b'\030\001\222\265\030\005\030\376\001 \001'
+  _globals['_UPDATEUSERREQUEST'].fields_by_name['password']._loaded_options = None
+  _globals['_UPDATEUSERREQUEST'].fields_by_name['password']._serialized_options = b'\030\001\222\265\030\004\020\010@H\230\265\030\001'
+  _globals['_DELETEUSERREQUEST'].fields_by_name['id']._loaded_options = None
+  _globals['_DELETEUSERREQUEST'].fields_by_name['id']._serialized_options = b'\222\265\030\004\010\001(\001'
+  _globals['_UNDELETEUSERREQUEST'].fields_by_name['id']._loaded_options = None
+  _globals['_UNDELETEUSERREQUEST'].fields_by_name['id']._serialized_options = b'\222\265\030\004\010\001(\001'
+  _globals['_CHANGEUSERSTATUSREQUEST'].fields_by_name['id']._loaded_options = None
+  _globals['_CHANGEUSERSTATUSREQUEST'].fields_by_name['id']._serialized_options = b'\222\265\030\004\010\001(\001'
+  _globals['_CHANGEUSERSTATUSREQUEST'].fields_by_name['reason']._loaded_options = None
+  _globals['_CHANGEUSERSTATUSREQUEST'].fields_by_name['reason']._serialized_options = b'\222\265\030\003\030\364\003'
   _globals['_USERSERVICE'].methods_by_name['CreateUser']._loaded_options = None
   _globals['_USERSERVICE'].methods_by_name['CreateUser']._serialized_options = b'\202\323\344\223\002\016\"\t/v1/users:\001*'
   _globals['_USERSERVICE'].methods_by_name['GetUser']._loaded_options = None
   _globals['_USERSERVICE'].methods_by_name['GetUser']._serialized_options = b'\202\323\344\223\002\020\022\016/v1/users/{id}'
   _globals['_USERSERVICE'].methods_by_name['ListUsers']._loaded_options = None
   _globals['_USERSERVICE'].methods_by_name['ListUsers']._serialized_options = b'\202\323\344\223\002\013\022\t/v1/users'
+  _globals['_USERSERVICE'].methods_by_name['BatchGetUsers']._loaded_options = None
+  _globals['_USERSERVICE'].methods_by_name['BatchGetUsers']._serialized_options = b'\202\323\344\223\002\024\022\022/v1/users:batchGet'
+  _globals['_USERSERVICE'].methods_by_name['BatchCreateUsers']._loaded_options = None
+  _globals['_USERSERVICE'].methods_by_name['BatchCreateUsers']._serialized_options = b'\202\323\344\223\002\032\"\025/v1/users:batchCreate:\001*'
+  _globals['_USERSERVICE'].methods_by_name['BatchDeleteUsers']._loaded_options = None
+  _globals['_USERSERVICE'].methods_by_name['BatchDeleteUsers']._serialized_options = b'\202\323\344\223\002\032\"\025/v1/users:batchDelete:\001*'
+  _globals['_USERSERVICE'].methods_by_name['ImportUsers']._loaded_options = None
+  _globals['_USERSERVICE'].methods_by_name['ImportUsers']._serialized_options = b'\202\323\344\223\002\025\"\020/v1/users:import:\001*\312A*\n\023ImportUsersResponse\022\023ImportUsersMetadata'
+  _globals['_USERSERVICE'].methods_by_name['ExportUsers']._loaded_options = None
+  _globals['_USERSERVICE'].methods_by_name['ExportUsers']._serialized_options = b'\202\323\344\223\002\025\"\020/v1/users:export:\001*\312A*\n\023ExportUsersResponse\022\023ExportUsersMetadata'
+  _globals['_USERSERVICE'].methods_by_name['WatchUsers']._loaded_options = None
+  _globals['_USERSERVICE'].methods_by_name['WatchUsers']._serialized_options = b'\202\323\344\223\002\021\022\017/v1/users:watch'
   _globals['_USERSERVICE'].methods_by_name['UpdateUser']._loaded_options = None
-  _globals['_USERSERVICE'].methods_by_name['UpdateUser']._serialized_options = b'\202\323\344\223\002\0232\016/v1/users/{id}:\001*'
+  _globals['_USERSERVICE'].methods_by_name['UpdateUser']._serialized_options = b'\202\323\344\223\002\0262\016/v1/users/{id}:\004user'
   _globals['_USERSERVICE'].methods_by_name['DeleteUser']._loaded_options = None
   _globals['_USERSERVICE'].methods_by_name['DeleteUser']._serialized_options = b'\202\323\344\223\002\020*\016/v1/users/{id}'
-  _globals['_USERRESPONSE']._serialized_start=95
-  _globals['_USERRESPONSE']._serialized_end=287
-  _globals['_CREATEUSERREQUEST']._serialized_start=289
-  _globals['_CREATEUSERREQUEST']._serialized_end=369
-  _globals['_GETUSERREQUEST']._serialized_start=371
-  _globals['_GETUSERREQUEST']._serialized_end=399
-  _globals['_LISTUSERSREQUEST']._serialized_start=401
-  _globals['_LISTUSERSREQUEST']._serialized_end=507
-  _globals['_LISTUSERSRESPONSE']._serialized_start=509
-  _globals['_LISTUSERSRESPONSE']._serialized_end=636
-  _globals['_UPDATEUSERREQUEST']._serialized_start=638
-  _globals['_UPDATEUSERREQUEST']._serialized_end=716
-  _globals['_DELETEUSERREQUEST']._serialized_start=718
-  _globals['_DELETEUSERREQUEST']._serialized_end=749
-  _globals['_DELETEUSERRESPONSE']._serialized_start=751
-  _globals['_DELETEUSERRESPONSE']._serialized_end=788
-  _globals['_USERSERVICE']._serialized_start=791
-  _globals['_USERSERVICE']._serialized_end=1198
+  _globals['_USERSERVICE'].methods_by_name['UndeleteUser']._loaded_options = None
+  _globals['_USERSERVICE'].methods_by_name['UndeleteUser']._serialized_options = b'\202\323\344\223\002\034\"\027/v1/users/{id}:undelete:\001*'
+  _globals['_USERSERVICE'].methods_by_name['ListDeletedUsers']._loaded_options = None
+  _globals['_USERSERVICE'].methods_by_name['ListDeletedUsers']._serialized_options = b'\202\323\344\223\002\027\022\025/v1/users:listDeleted'
+  _globals['_USERSERVICE'].methods_by_name['SuspendUser']._loaded_options = None
+  _globals['_USERSERVICE'].methods_by_name['SuspendUser']._serialized_options = b'\202\323\344\223\002\033\"\026/v1/users/{id}:suspend:\001*'
+  _globals['_USERSERVICE'].methods_by_name['DeactivateUser']._loaded_options = None
+  _globals['_USERSERVICE'].methods_by_name['DeactivateUser']._serialized_options = b'\202\323\344\223\002\036\"\031/v1/users/{id}:deactivate:\001*'
+  _globals['_USERSERVICE'].methods_by_name['ReactivateUser']._loaded_options = None
+  _globals['_USERSERVICE'].methods_by_name['ReactivateUser']._serialized_options = b'\202\323\344\223\002\036\"\031/v1/users/{id}:reactivate:\001*'
+  _globals['_USERRESPONSE']._serialized_start=219
+  _globals['_USERRESPONSE']._serialized_end=512
+  _globals['_CREATEUSERREQUEST']._serialized_start=515
+  _globals['_CREATEUSERREQUEST']._serialized_end=653
+  _globals['_GETUSERREQUEST']._serialized_start=655
+  _globals['_GETUSERREQUEST']._serialized_end=693
+  _globals['_LISTUSERSREQUEST']._serialized_start=696
+  _globals['_LISTUSERSREQUEST']._serialized_end=944
+  _globals['_LISTUSERSRESPONSE']._serialized_start=947
+  _globals['_LISTUSERSRESPONSE']._serialized_end=1099
+  _globals['_BATCHGETUSERSREQUEST']._serialized_start=1101
+  _globals['_BATCHGETUSERSREQUEST']._serialized_end=1167
+  _globals['_BATCHCREATEUSERSREQUEST']._serialized_start=1169
+  _globals['_BATCHCREATEUSERSREQUEST']._serialized_end=1266
+  _globals['_BATCHDELETEUSERSREQUEST']._serialized_start=1268
+  _globals['_BATCHDELETEUSERSREQUEST']._serialized_end=1337
+  _globals['_BATCHUSERRESULT']._serialized_start=1339
+  _globals['_BATCHUSERRESULT']._serialized_end=1424
+  _globals['_BATCHUSERSRESPONSE']._serialized_start=1426
+  _globals['_BATCHUSERSRESPONSE']._serialized_end=1484
+  _globals['_IMPORTUSERSREQUEST']._serialized_start=1486
+  _globals['_IMPORTUSERSREQUEST']._serialized_end=1594
+  _globals['_IMPORTUSERSMETADATA']._serialized_start=1597
+  _globals['_IMPORTUSERSMETADATA']._serialized_end=1760
+  _globals['_IMPORTUSERSRESPONSE']._serialized_start=1762
+  _globals['_IMPORTUSERSRESPONSE']._serialized_end=1887
+  _globals['_IMPORTROWERROR']._serialized_start=1889
+  _globals['_IMPORTROWERROR']._serialized_end=1950
+  _globals['_EXPORTUSERSREQUEST']._serialized_start=1952
+  _globals['_EXPORTUSERSREQUEST']._serialized_end=2034
+  _globals['_EXPORTUSERSMETADATA']._serialized_start=2037
+  _globals['_EXPORTUSERSMETADATA']._serialized_end=2179
+  _globals['_EXPORTUSERSRESPONSE']._serialized_start=2181
+  _globals['_EXPORTUSERSRESPONSE']._serialized_end=2261
+  _globals['_WATCHUSERSREQUEST']._serialized_start=2263
+  _globals['_WATCHUSERSREQUEST']._serialized_end=2304
+  _globals['_USEREVENT']._serialized_start=2307
+  _globals['_USEREVENT']._serialized_end=2435
+  _globals['_USERUPDATE']._serialized_start=2437
+  _globals['_USERUPDATE']._serialized_end=2529
+  _globals['_UPDATEUSERREQUEST']._serialized_start=2532
+  _globals['_UPDATEUSERREQUEST']._serialized_end=2752
+  _globals['_DELETEUSERREQUEST']._serialized_start=2754
+  _globals['_DELETEUSERREQUEST']._serialized_end=2809
+  _globals['_DELETEUSERRESPONSE']._serialized_start=2811
+  _globals['_DELETEUSERRESPONSE']._serialized_end=2848
+  _globals['_UNDELETEUSERREQUEST']._serialized_start=2850
+  _globals['_UNDELETEUSERREQUEST']._serialized_end=2893
+  _globals['_CHANGEUSERSTATUSREQUEST']._serialized_start=2895
+  _globals['_CHANGEUSERSTATUSREQUEST']._serialized_end=2967
+  _globals['_USERSERVICE']._serialized_start=2970
+  _globals['_USERSERVICE']._serialized_end=4552
 # @@protoc_insertion_point(module_scope)
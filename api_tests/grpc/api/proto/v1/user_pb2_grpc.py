@@ -4,6 +4,7 @@ import grpc
 import warnings
 
 from api.proto.v1 import user_pb2 as api_dot_proto_dot_v1_dot_user__pb2
+from google.longrunning import operations_pb2 as google_dot_longrunning_dot_operations__pb2
 
 GRPC_GENERATED_VERSION = '1.76.0'
 GRPC_VERSION = grpc.__version__
@@ -49,6 +50,36 @@ class UserServiceStub(object):
                 request_serializer=api_dot_proto_dot_v1_dot_user__pb2.ListUsersRequest.SerializeToString,
                 response_deserializer=api_dot_proto_dot_v1_dot_user__pb2.ListUsersResponse.FromString,
                 _registered_method=True)
+        self.BatchGetUsers = channel.unary_unary(
+                '/v1.UserService/BatchGetUsers',
+                request_serializer=api_dot_proto_dot_v1_dot_user__pb2.BatchGetUsersRequest.SerializeToString,
+                response_deserializer=api_dot_proto_dot_v1_dot_user__pb2.BatchUsersResponse.FromString,
+                _registered_method=True)
+        self.BatchCreateUsers = channel.unary_unary(
+                '/v1.UserService/BatchCreateUsers',
+                request_serializer=api_dot_proto_dot_v1_dot_user__pb2.BatchCreateUsersRequest.SerializeToString,
+                response_deserializer=api_dot_proto_dot_v1_dot_user__pb2.BatchUsersResponse.FromString,
+                _registered_method=True)
+        self.BatchDeleteUsers = channel.unary_unary(
+                '/v1.UserService/BatchDeleteUsers',
+                request_serializer=api_dot_proto_dot_v1_dot_user__pb2.BatchDeleteUsersRequest.SerializeToString,
+                response_deserializer=api_dot_proto_dot_v1_dot_user__pb2.BatchUsersResponse.FromString,
+                _registered_method=True)
+        self.ImportUsers = channel.unary_unary(
+                '/v1.UserService/ImportUsers',
+                request_serializer=api_dot_proto_dot_v1_dot_user__pb2.ImportUsersRequest.SerializeToString,
+                response_deserializer=google_dot_longrunning_dot_operations__pb2.Operation.FromString,
+                _registered_method=True)
+        self.ExportUsers = channel.unary_unary(
+                '/v1.UserService/ExportUsers',
+                request_serializer=api_dot_proto_dot_v1_dot_user__pb2.ExportUsersRequest.SerializeToString,
+                response_deserializer=google_dot_longrunning_dot_operations__pb2.Operation.FromString,
+                _registered_method=True)
+        self.WatchUsers = channel.unary_stream(
+                '/v1.UserService/WatchUsers',
+                request_serializer=api_dot_proto_dot_v1_dot_user__pb2.WatchUsersRequest.SerializeToString,
+                response_deserializer=api_dot_proto_dot_v1_dot_user__pb2.UserEvent.FromString,
+                _registered_method=True)
         self.UpdateUser = channel.unary_unary(
                 '/v1.UserService/UpdateUser',
                 request_serializer=api_dot_proto_dot_v1_dot_user__pb2.UpdateUserRequest.SerializeToString,
@@ -59,6 +90,31 @@ class UserServiceStub(object):
                 request_serializer=api_dot_proto_dot_v1_dot_user__pb2.DeleteUserRequest.SerializeToString,
                 response_deserializer=api_dot_proto_dot_v1_dot_user__pb2.DeleteUserResponse.FromString,
                 _registered_method=True)
+        self.UndeleteUser = channel.unary_unary(
+                '/v1.UserService/UndeleteUser',
+                request_serializer=api_dot_proto_dot_v1_dot_user__pb2.UndeleteUserRequest.SerializeToString,
+                response_deserializer=api_dot_proto_dot_v1_dot_user__pb2.UserResponse.FromString,
+                _registered_method=True)
+        self.ListDeletedUsers = channel.unary_unary(
+                '/v1.UserService/ListDeletedUsers',
+                request_serializer=api_dot_proto_dot_v1_dot_user__pb2.ListUsersRequest.SerializeToString,
+                response_deserializer=api_dot_proto_dot_v1_dot_user__pb2.ListUsersResponse.FromString,
+                _registered_method=True)
+        self.SuspendUser = channel.unary_unary(
+                '/v1.UserService/SuspendUser',
+                request_serializer=api_dot_proto_dot_v1_dot_user__pb2.ChangeUserStatusRequest.SerializeToString,
+                response_deserializer=api_dot_proto_dot_v1_dot_user__pb2.UserResponse.FromString,
+                _registered_method=True)
+        self.DeactivateUser = channel.unary_unary(
+                '/v1.UserService/DeactivateUser',
+                request_serializer=api_dot_proto_dot_v1_dot_user__pb2.ChangeUserStatusRequest.SerializeToString,
+                response_deserializer=api_dot_proto_dot_v1_dot_user__pb2.UserResponse.FromString,
+                _registered_method=True)
+        self.ReactivateUser = channel.unary_unary(
+                '/v1.UserService/ReactivateUser',
+                request_serializer=api_dot_proto_dot_v1_dot_user__pb2.ChangeUserStatusRequest.SerializeToString,
+                response_deserializer=api_dot_proto_dot_v1_dot_user__pb2.UserResponse.FromString,
+                _registered_method=True)
 
 
 class UserServiceServicer(object):
@@ -85,8 +141,50 @@ class UserServiceServicer(object):
         context.set_details('Method not implemented!')
         raise NotImplementedError('Method not implemented!')
 
+    def BatchGetUsers(self, request, context):
+        """Batch Get Users (Admin only - AIP-231)
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def BatchCreateUsers(self, request, context):
+        """Batch Create Users (Admin only - AIP-233)
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def BatchDeleteUsers(self, request, context):
+        """Batch Delete Users (Admin only - AIP-235)
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def ImportUsers(self, request, context):
+        """Import Users (Admin only - CSV or NDJSON; poll the returned operation)
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def ExportUsers(self, request, context):
+        """Export Users (Admin only - CSV or NDJSON; poll the returned operation)
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def WatchUsers(self, request, context):
+        """Watch Users (Admin only - server stream of user changes; NDJSON over REST)
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
     def UpdateUser(self, request, context):
-        """Update User (Admin only - strict CRUD)
+        """Update User (Admin only - partial update driven by update_mask)
         """
         context.set_code(grpc.StatusCode.UNIMPLEMENTED)
         context.set_details('Method not implemented!')
@@ -99,6 +197,43 @@ class UserServiceServicer(object):
         context.set_details('Method not implemented!')
         raise NotImplementedError('Method not implemented!')
 
+    def UndeleteUser(self, request, context):
+        """Undelete User (Admin only - restores a soft-deleted user)
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def ListDeletedUsers(self, request, context):
+        """List Deleted Users (Admin only - soft-deleted users awaiting purge).
+        Supports page, limit, order_by/sort and include_total only; search, role, scope,
+        filter and page_token are rejected with INVALID_ARGUMENT.
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def SuspendUser(self, request, context):
+        """Suspend User (Admin only - blocks login and revokes sessions)
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def DeactivateUser(self, request, context):
+        """Deactivate User (Admin only - blocks login and revokes sessions)
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def ReactivateUser(self, request, context):
+        """Reactivate User (Admin only)
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
 
 def add_UserServiceServicer_to_server(servicer, server):
     rpc_method_handlers = {
@@ -117,6 +252,36 @@ def add_UserServiceServicer_to_server(servicer, server):
                     request_deserializer=api_dot_proto_dot_v1_dot_user__pb2.ListUsersRequest.FromString,
                     response_serializer=api_dot_proto_dot_v1_dot_user__pb2.ListUsersResponse.SerializeToString,
             ),
+            'BatchGetUsers': grpc.unary_unary_rpc_method_handler(
+                    servicer.BatchGetUsers,
+                    request_deserializer=api_dot_proto_dot_v1_dot_user__pb2.BatchGetUsersRequest.FromString,
+                    response_serializer=api_dot_proto_dot_v1_dot_user__pb2.BatchUsersResponse.SerializeToString,
+            ),
+            'BatchCreateUsers': grpc.unary_unary_rpc_method_handler(
+                    servicer.BatchCreateUsers,
+                    request_deserializer=api_dot_proto_dot_v1_dot_user__pb2.BatchCreateUsersRequest.FromString,
+                    response_serializer=api_dot_proto_dot_v1_dot_user__pb2.BatchUsersResponse.SerializeToString,
+            ),
+            'BatchDeleteUsers': grpc.unary_unary_rpc_method_handler(
+                    servicer.BatchDeleteUsers,
+                    request_deserializer=api_dot_proto_dot_v1_dot_user__pb2.BatchDeleteUsersRequest.FromString,
+                    response_serializer=api_dot_proto_dot_v1_dot_user__pb2.BatchUsersResponse.SerializeToString,
+            ),
+            'ImportUsers': grpc.unary_unary_rpc_method_handler(
+                    servicer.ImportUsers,
+                    request_deserializer=api_dot_proto_dot_v1_dot_user__pb2.ImportUsersRequest.FromString,
+                    response_serializer=google_dot_longrunning_dot_operations__pb2.Operation.SerializeToString,
+            ),
+            'ExportUsers': grpc.unary_unary_rpc_method_handler(
+                    servicer.ExportUsers,
+                    request_deserializer=api_dot_proto_dot_v1_dot_user__pb2.ExportUsersRequest.FromString,
+                    response_serializer=google_dot_longrunning_dot_operations__pb2.Operation.SerializeToString,
+            ),
+            'WatchUsers': grpc.unary_stream_rpc_method_handler(
+                    servicer.WatchUsers,
+                    request_deserializer=api_dot_proto_dot_v1_dot_user__pb2.WatchUsersRequest.FromString,
+                    response_serializer=api_dot_proto_dot_v1_dot_user__pb2.UserEvent.SerializeToString,
+            ),
             'UpdateUser': grpc.unary_unary_rpc_method_handler(
                     servicer.UpdateUser,
                     request_deserializer=api_dot_proto_dot_v1_dot_user__pb2.UpdateUserRequest.FromString,
@@ -127,6 +292,31 @@ def add_UserServiceServicer_to_server(servicer, server):
                     request_deserializer=api_dot_proto_dot_v1_dot_user__pb2.DeleteUserRequest.FromString,
                     response_serializer=api_dot_proto_dot_v1_dot_user__pb2.DeleteUserResponse.SerializeToString,
             ),
+            'UndeleteUser': grpc.unary_unary_rpc_method_handler(
+                    servicer.UndeleteUser,
+                    request_deserializer=api_dot_proto_dot_v1_dot_user__pb2.UndeleteUserRequest.FromString,
+                    response_serializer=api_dot_proto_dot_v1_dot_user__pb2.UserResponse.SerializeToString,
+            ),
+            'ListDeletedUsers': grpc.unary_unary_rpc_method_handler(
+                    servicer.ListDeletedUsers,
+                    request_deserializer=api_dot_proto_dot_v1_dot_user__pb2.ListUsersRequest.FromString,
+                    response_serializer=api_dot_proto_dot_v1_dot_user__pb2.ListUsersResponse.SerializeToString,
+            ),
+            'SuspendUser': grpc.unary_unary_rpc_method_handler(
+                    servicer.SuspendUser,
+                    request_deserializer=api_dot_proto_dot_v1_dot_user__pb2.ChangeUserStatusRequest.FromString,
+                    response_serializer=api_dot_proto_dot_v1_dot_user__pb2.UserResponse.SerializeToString,
+            ),
+            'DeactivateUser': grpc.unary_unary_rpc_method_handler(
+                    servicer.DeactivateUser,
+                    request_deserializer=api_dot_proto_dot_v1_dot_user__pb2.ChangeUserStatusRequest.FromString,
+                    response_serializer=api_dot_proto_dot_v1_dot_user__pb2.UserResponse.SerializeToString,
+            ),
+            'ReactivateUser': grpc.unary_unary_rpc_method_handler(
+                    servicer.ReactivateUser,
+                    request_deserializer=api_dot_proto_dot_v1_dot_user__pb2.ChangeUserStatusRequest.FromString,
+                    response_serializer=api_dot_proto_dot_v1_dot_user__pb2.UserResponse.SerializeToString,
+            ),
     }
     generic_handler = grpc.method_handlers_generic_handler(
             'v1.UserService', rpc_method_handlers)
@@ -219,6 +409,168 @@ class UserService(object):
             metadata,
             _registered_method=True)
 
+    @staticmethod
+    def BatchGetUsers(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/v1.UserService/BatchGetUsers',
+            api_dot_proto_dot_v1_dot_user__pb2.BatchGetUsersRequest.SerializeToString,
+            api_dot_proto_dot_v1_dot_user__pb2.BatchUsersResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def BatchCreateUsers(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/v1.UserService/BatchCreateUsers',
+            api_dot_proto_dot_v1_dot_user__pb2.BatchCreateUsersRequest.SerializeToString,
+            api_dot_proto_dot_v1_dot_user__pb2.BatchUsersResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def BatchDeleteUsers(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/v1.UserService/BatchDeleteUsers',
+            api_dot_proto_dot_v1_dot_user__pb2.BatchDeleteUsersRequest.SerializeToString,
+            api_dot_proto_dot_v1_dot_user__pb2.BatchUsersResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def ImportUsers(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/v1.UserService/ImportUsers',
+            api_dot_proto_dot_v1_dot_user__pb2.ImportUsersRequest.SerializeToString,
+            google_dot_longrunning_dot_operations__pb2.Operation.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def ExportUsers(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/v1.UserService/ExportUsers',
+            api_dot_proto_dot_v1_dot_user__pb2.ExportUsersRequest.SerializeToString,
+            google_dot_longrunning_dot_operations__pb2.Operation.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def WatchUsers(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_stream(
+            request,
+            target,
+            '/v1.UserService/WatchUsers',
+            api_dot_proto_dot_v1_dot_user__pb2.WatchUsersRequest.SerializeToString,
+            api_dot_proto_dot_v1_dot_user__pb2.UserEvent.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
     @staticmethod
     def UpdateUser(request,
             target,
@@ -272,3 +624,138 @@ class UserService(object):
             timeout,
             metadata,
             _registered_method=True)
+
+    @staticmethod
+    def UndeleteUser(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/v1.UserService/UndeleteUser',
+            api_dot_proto_dot_v1_dot_user__pb2.UndeleteUserRequest.SerializeToString,
+            api_dot_proto_dot_v1_dot_user__pb2.UserResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def ListDeletedUsers(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/v1.UserService/ListDeletedUsers',
+            api_dot_proto_dot_v1_dot_user__pb2.ListUsersRequest.SerializeToString,
+            api_dot_proto_dot_v1_dot_user__pb2.ListUsersResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def SuspendUser(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/v1.UserService/SuspendUser',
+            api_dot_proto_dot_v1_dot_user__pb2.ChangeUserStatusRequest.SerializeToString,
+            api_dot_proto_dot_v1_dot_user__pb2.UserResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def DeactivateUser(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/v1.UserService/DeactivateUser',
+            api_dot_proto_dot_v1_dot_user__pb2.ChangeUserStatusRequest.SerializeToString,
+            api_dot_proto_dot_v1_dot_user__pb2.UserResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def ReactivateUser(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/v1.UserService/ReactivateUser',
+            api_dot_proto_dot_v1_dot_user__pb2.ChangeUserStatusRequest.SerializeToString,
+            api_dot_proto_dot_v1_dot_user__pb2.UserResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
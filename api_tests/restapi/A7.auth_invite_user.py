@@ -0,0 +1,37 @@
+import sys
+import os
+import time
+sys.path.append(os.path.abspath(os.path.dirname(__file__)))
+from utils import send_and_print, BASE_URL, load_config
+
+print("--- INVITE USER (ADMIN) ---")
+
+token = load_config("accessToken")
+if not token:
+    print("Error: No access token. Run A2.auth_login.py first.")
+    sys.exit(1)
+
+# Unique email
+unique_id = int(time.time())
+email = f"invited_{unique_id}@example.com"
+
+url = f"{BASE_URL}/auth/invite"
+headers = {
+    "Authorization": f"Bearer {token}"
+}
+payload = {
+    "email": email,
+    "name": "Invited Via Python",
+    "role": "user"
+}
+
+response = send_and_print(
+    url=url,
+    headers=headers,
+    method="POST",
+    body=payload,
+    output_file=f"{os.path.splitext(os.path.basename(__file__))[0]}.json"
+)
+
+if response.status_code == 201:
+    print(f">>> Invitation sent to {email}. Copy the token from the email to run A8.auth_accept_invite.py.")
\ No newline at end of file
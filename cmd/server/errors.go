@@ -0,0 +1,122 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math"
+	"net/http"
+	"net/textproto"
+	"strconv"
+	"strings"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/genproto/googleapis/rpc/code"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// errorEnvelope is the JSON body of every gateway error:
+//
+//	{"error": {"code": 404, "status": "NOT_FOUND", "message": "...", "details": [{"@type": ...}]}}
+type errorEnvelope struct {
+	Error errorBody `json:"error"`
+}
+
+type errorBody struct {
+	Code    int               `json:"code"`   // HTTP status
+	Status  string            `json:"status"` // gRPC code name
+	Message string            `json:"message"`
+	Details []json.RawMessage `json:"details"` // google.rpc error details, always present
+}
+
+// ErrorHandler renders gRPC errors as an errorEnvelope with the matching HTTP status.
+// Header and trailer metadata are forwarded like runtime.DefaultHTTPErrorHandler does,
+// and a RetryInfo detail is also exposed as a Retry-After header.
+func ErrorHandler(ctx context.Context, mux *runtime.ServeMux, marshaler runtime.Marshaler, w http.ResponseWriter, r *http.Request, err error) {
+	md, hasMetadata := runtime.ServerMetadataFromContext(ctx)
+	if hasMetadata {
+		forwardHeaderMetadata(w, md.HeaderMD)
+	}
+	// Trailers are only sent to clients that announce support for them (RFC 7230 4.1.2)
+	forwardTrailers := hasMetadata && acceptsTrailers(r)
+
+	st := status.Convert(err)
+	httpStatus := runtime.HTTPStatusFromCode(st.Code())
+
+	// Errors raised by the gateway itself (e.g. unsupported method) carry their own HTTP status
+	var httpErr *runtime.HTTPStatusError
+	if errors.As(err, &httpErr) {
+		st = status.Convert(httpErr.Err)
+		httpStatus = httpErr.HTTPStatus
+	}
+
+	body := errorEnvelope{Error: errorBody{
+		Code:    httpStatus,
+		Status:  code.Code(st.Code()).String(),
+		Message: st.Message(),
+		Details: []json.RawMessage{},
+	}}
+	for _, detail := range st.Proto().GetDetails() {
+		raw, err := protojson.Marshal(detail)
+		if err != nil {
+			continue // Unknown detail type
+		}
+		body.Error.Details = append(body.Error.Details, raw)
+	}
+
+	for _, detail := range st.Details() {
+		retry, ok := detail.(*errdetails.RetryInfo)
+		if !ok {
+			continue
+		}
+		if delay := retry.GetRetryDelay().AsDuration(); delay > 0 {
+			w.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(delay.Seconds()))))
+		}
+	}
+
+	buf, err := json.Marshal(body)
+	if err != nil {
+		http.Error(w, `{"error": {"code": 500, "status": "INTERNAL", "message": "failed to marshal error"}}`, http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Del("Trailer")
+	if forwardTrailers {
+		for key := range md.TrailerMD {
+			w.Header().Add("Trailer", textproto.CanonicalMIMEHeaderKey(runtime.MetadataTrailerPrefix+key))
+		}
+		w.Header().Set("Transfer-Encoding", "chunked")
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(httpStatus)
+	w.Write(buf)
+
+	if forwardTrailers {
+		for key, values := range md.TrailerMD {
+			for _, value := range values {
+				w.Header().Add(runtime.MetadataTrailerPrefix+key, value)
+			}
+		}
+	}
+}
+
+// forwardHeaderMetadata copies header metadata (ETag, Idempotency-Replayed, ...) to the
+// response. The mux doesn't expose its matcher, so this uses the one main registers.
+func forwardHeaderMetadata(w http.ResponseWriter, md metadata.MD) {
+	for key, values := range md {
+		name, ok := OutgoingHeaderMatcher(key)
+		if !ok {
+			continue
+		}
+		for _, value := range values {
+			w.Header().Add(name, value)
+		}
+	}
+}
+
+func acceptsTrailers(r *http.Request) bool {
+	return strings.Contains(strings.ToLower(r.Header.Get("TE")), "trailers")
+}
\ No newline at end of file
@@ -0,0 +1,82 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+func handleError(r *http.Request, md runtime.ServerMetadata, err error) *httptest.ResponseRecorder {
+	ctx := runtime.NewServerMetadataContext(context.Background(), md)
+	rec := httptest.NewRecorder()
+	ErrorHandler(ctx, runtime.NewServeMux(), &runtime.JSONPb{}, rec, r, err)
+	return rec
+}
+
+func TestErrorHandlerForwardsMetadata(t *testing.T) {
+	md := runtime.ServerMetadata{
+		HeaderMD:  metadata.Pairs("idempotency-replayed", "true", "x-custom", "a"),
+		TrailerMD: metadata.Pairs("x-trailer", "b"),
+	}
+	rec := handleError(httptest.NewRequest(http.MethodGet, "/v1/users/1", nil), md, status.Error(codes.NotFound, "user not found"))
+
+	if rec.Code != http.StatusNotFound {
+		t.Errorf("status %d, want 404", rec.Code)
+	}
+	header := rec.Result().Header
+	if got := header.Get("Idempotency-Replayed"); got != "true" {
+		t.Errorf("Idempotency-Replayed = %q, want true", got)
+	}
+	if got := header.Get("Grpc-Metadata-X-Custom"); got != "a" {
+		t.Errorf("Grpc-Metadata-X-Custom = %q, want a", got)
+	}
+	// Trailers need TE: trailers
+	if got := rec.Result().Trailer.Get("Grpc-Trailer-X-Trailer"); got != "" {
+		t.Errorf("trailer sent without TE: trailers: %q", got)
+	}
+
+	var body errorEnvelope
+	if err := json.Unmarshal(rec.Body.Bytes(), &body); err != nil {
+		t.Fatal(err)
+	}
+	if body.Error.Status != "NOT_FOUND" || body.Error.Message != "user not found" {
+		t.Errorf("body %+v", body.Error)
+	}
+}
+
+func TestErrorHandlerForwardsTrailers(t *testing.T) {
+	r := httptest.NewRequest(http.MethodGet, "/v1/users/1", nil)
+	r.Header.Set("TE", "trailers")
+	md := runtime.ServerMetadata{TrailerMD: metadata.Pairs("x-trailer", "b")}
+
+	rec := handleError(r, md, status.Error(codes.Internal, "internal error"))
+	if got := rec.Result().Trailer.Get("Grpc-Trailer-X-Trailer"); got != "b" {
+		t.Errorf("Grpc-Trailer-X-Trailer = %q, want b", got)
+	}
+}
+
+func TestErrorHandlerRetryAfter(t *testing.T) {
+	st, err := status.New(codes.ResourceExhausted, "queue full").WithDetails(&errdetails.RetryInfo{RetryDelay: durationpb.New(1500 * time.Millisecond)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	rec := handleError(httptest.NewRequest(http.MethodPost, "/v1/users:import", nil), runtime.ServerMetadata{}, st.Err())
+	if got := rec.Result().Header.Get("Retry-After"); got != "2" {
+		t.Errorf("Retry-After = %q, want 2", got)
+	}
+
+	rec = handleError(httptest.NewRequest(http.MethodPost, "/v1/users", nil), runtime.ServerMetadata{}, status.Error(codes.Aborted, "conflict"))
+	if got := rec.Result().Header.Get("Retry-After"); got != "" {
+		t.Errorf("Retry-After = %q without RetryInfo, want none", got)
+	}
+}
\ No newline at end of file
@@ -13,16 +13,23 @@ import (
 
 	pb "starter-kit-grpc-golang/api/gen/v1"
 	"starter-kit-grpc-golang/config"
+	"starter-kit-grpc-golang/internal/crash"
 	"starter-kit-grpc-golang/internal/grpc_handler"
+	"starter-kit-grpc-golang/internal/health"
 	"starter-kit-grpc-golang/internal/interceptor"
+	"starter-kit-grpc-golang/internal/jobs"
+	"starter-kit-grpc-golang/internal/metrics"
 	"starter-kit-grpc-golang/internal/repository"
 	"starter-kit-grpc-golang/internal/service"
+	"starter-kit-grpc-golang/internal/tracing"
 	"starter-kit-grpc-golang/pkg/logger"
 	"starter-kit-grpc-golang/pkg/swagger"
 
+	"cloud.google.com/go/longrunning/autogen/longrunningpb"
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/reflection"
 	"google.golang.org/protobuf/proto"
 )
@@ -44,41 +51,144 @@ func HttpResponseModifier(ctx context.Context, w http.ResponseWriter, p proto.Me
 	return nil
 }
 
+// HeaderMatcher forwards If-Match, Idempotency-Key and X-Request-Id as plain metadata,
+// so REST and gRPC clients send them the same way
+func HeaderMatcher(key string) (string, bool) {
+	switch http.CanonicalHeaderKey(key) {
+	case "If-Match":
+		return "if-match", true
+	case "Idempotency-Key":
+		return interceptor.IdempotencyKeyHeader, true
+	case "X-Request-Id":
+		return interceptor.RequestIDHeader, true
+	}
+	return runtime.DefaultHeaderMatcher(key)
+}
+
+// OutgoingHeaderMatcher exposes the "etag" and "idempotency-replayed" metadata as plain headers.
+// "x-request-id" is dropped, since RequestIDHandler already echoes it.
+func OutgoingHeaderMatcher(key string) (string, bool) {
+	switch key {
+	case "etag":
+		return "ETag", true
+	case "idempotency-replayed":
+		return "Idempotency-Replayed", true
+	case interceptor.RequestIDHeader:
+		return "", false // Already set by RequestIDHandler
+	}
+	return runtime.MetadataHeaderPrefix + key, true
+}
+
 func main() {
 	// 1. Load Config & Logger
 	cfg := config.LoadConfig()
 	logger.InitLogger(cfg.Env)
 	logger.Log.Info("Starting server...", "env", cfg.Env)
 
+	shutdownTracing, err := tracing.Init(context.Background(), cfg)
+	if err != nil {
+		logger.Log.Error("Failed to initialize tracing", "error", err)
+		os.Exit(1)
+	}
+
 	// 2. Connect DB
 	config.ConnectDB(cfg)
+	if err := config.DB.Use(metrics.NewGORMPlugin()); err != nil {
+		logger.Log.Warn("Failed to install GORM metrics", "error", err)
+	}
+	if err := config.DB.Use(tracing.NewGORMPlugin()); err != nil {
+		logger.Log.Warn("Failed to install GORM tracing", "error", err)
+	}
+	if err := metrics.RegisterDBStats(config.DB, cfg.Database.Name); err != nil {
+		logger.Log.Warn("Failed to register DB pool metrics", "error", err)
+	}
 
 	// 3. Dependency Injection
 	userRepo := repository.NewUserRepository(config.DB)
 	tokenRepo := repository.NewTokenRepository(config.DB)
+	auditRepo := repository.NewAuditRepository(config.DB, cfg.AuditSecret)
+	idempotencyRepo := repository.NewIdempotencyRepository(config.DB)
+	transactor := repository.NewTransactor(config.DB)
 
 	tokenService := service.NewTokenService(tokenRepo, cfg)
 	emailService := service.NewEmailService(cfg)
-	userService := service.NewUserService(userRepo)
-	authService := service.NewAuthService(userRepo, tokenRepo, tokenService, emailService, cfg)
+	auditService := service.NewAuditService(auditRepo, cfg.AuditSecret)
+	userEvents := service.NewUserEventBroker(cfg)
+	userService := service.NewUserService(userRepo, tokenRepo, transactor, auditService, userEvents, cfg)
+	authService := service.NewAuthService(userRepo, tokenRepo, transactor, tokenService, emailService, auditService, userEvents, cfg)
+	operationService := service.NewOperationService(cfg)
+	userBulkService := service.NewUserBulkService(userService, authService, operationService)
 
 	authHandler := grpc_handler.NewAuthHandler(authService)
-	userHandler := grpc_handler.NewUserHandler(userService)
-	healthHandler := grpc_handler.NewHealthHandler()
+	userHandler := grpc_handler.NewUserHandler(userService, userBulkService)
+	healthMonitor := health.NewMonitor()
+	healthHandler := grpc_handler.NewHealthHandler(healthMonitor)
+	auditHandler := grpc_handler.NewAuditHandler(auditService)
+	operationHandler := grpc_handler.NewOperationHandler(operationService)
+
+	// Background Jobs
+	jobsCtx, stopJobs := context.WithCancel(context.Background())
+	defer stopJobs()
+	jobs.StartUserPurgeJob(jobsCtx, userService, cfg.SoftDelete.PurgeInterval, cfg.SoftDelete.Retention)
+	jobs.StartOperationWorkers(jobsCtx, operationService, cfg.Operations.Workers, cfg.Operations.Retention)
+	jobs.StartIdempotencyPurgeJob(jobsCtx, idempotencyRepo, cfg.IdempotencyTTL)
 
 	// 4. Setup gRPC Server
+	crashReporter := crash.NewNopReporter()
+	if cfg.CrashReportFile != "" {
+		crashReporter = crash.NewFileReporter(cfg.CrashReportFile)
+	}
+	panics := crash.NewCounter()
+	if err := metrics.RegisterPanics(panics); err != nil {
+		logger.Log.Warn("Failed to register panic metrics", "error", err)
+	}
+
+	// rateLimiter := interceptor.NewIPRateLimiter(5, 20) // Allow 5 requests per second with burst of 20
 	grpcServer := grpc.NewServer(
+		grpc.StatsHandler(tracing.ServerHandler()),
 		grpc.ChainUnaryInterceptor(
-			interceptor.RecoveryInterceptor(),
-			interceptor.LoggerInterceptor(),
-			// interceptor.RateLimitInterceptor(), // --> Uncomment for using RateLimiter
-			interceptor.AuthInterceptor(cfg),
+			interceptor.DeadlineInterceptor(cfg.RPCTimeout),
+			interceptor.MetricsInterceptor(),
+			interceptor.RequestIDInterceptor(),
+			interceptor.RecoveryInterceptor(crashReporter, panics),
+			interceptor.LoggerInterceptor(cfg.LogPayloads),
+			// interceptor.RateLimitInterceptor(rateLimiter), // --> Uncomment for using RateLimiter
+			interceptor.AuthInterceptor(cfg, userRepo),
+			interceptor.EmailVerificationInterceptor(cfg),
+			interceptor.ValidationInterceptor(),
+			interceptor.IdempotencyInterceptor(idempotencyRepo, cfg.IdempotencyTTL),
+		),
+		// Streams get the same chain, minus idempotency
+		grpc.ChainStreamInterceptor(
+			interceptor.StreamMetricsInterceptor(),
+			interceptor.StreamRequestIDInterceptor(),
+			interceptor.StreamRecoveryInterceptor(crashReporter, panics),
+			interceptor.StreamLoggerInterceptor(cfg.LogPayloads, interceptor.MetricsMessageHook),
+			// interceptor.StreamRateLimitInterceptor(rateLimiter), // --> Uncomment for using RateLimiter
+			interceptor.StreamAuthInterceptor(cfg, userRepo),
+			interceptor.StreamEmailVerificationInterceptor(cfg),
+			interceptor.StreamValidationInterceptor(),
 		),
 	)
 
 	pb.RegisterAuthServiceServer(grpcServer, authHandler)
 	pb.RegisterUserServiceServer(grpcServer, userHandler)
 	pb.RegisterHealthServiceServer(grpcServer, healthHandler)
+	pb.RegisterAuditServiceServer(grpcServer, auditHandler)
+	pb.RegisterOperationServiceServer(grpcServer, operationHandler)
+	longrunningpb.RegisterOperationsServer(grpcServer, operationHandler)
+	healthpb.RegisterHealthServer(grpcServer, healthMonitor.Server())
+
+	// Health: the database and disk gate readiness; SMTP is only reported, since
+	// most RPCs work without it
+	healthMonitor.Register(health.NewDBChecker(config.DB), true)
+	healthMonitor.Register(health.NewDiskChecker(cfg.Health.DiskPath, uint64(cfg.Health.DiskMinFreeMB)<<20), true)
+	if cfg.SMTP.Host != "" {
+		healthMonitor.Register(health.NewSMTPChecker(cfg.SMTP.Host, cfg.SMTP.Port), false)
+	}
+	healthMonitor.SetServices(grpcServer.GetServiceInfo())
+	healthMonitor.Run(jobsCtx)
+	jobs.StartHealthChecks(jobsCtx, healthMonitor, cfg.Health.Interval)
 
 	if cfg.Env == "development" {
 		reflection.Register(grpcServer)
@@ -105,44 +215,77 @@ func main() {
 		time.Sleep(time.Second)
 
 		ctx := context.Background()
-		
+
 		// Create the gRPC-Gateway Mux
 		gwmux := runtime.NewServeMux(
 			runtime.WithForwardResponseOption(HttpResponseModifier),
+			runtime.WithIncomingHeaderMatcher(HeaderMatcher),
+			runtime.WithOutgoingHeaderMatcher(OutgoingHeaderMatcher),
+			runtime.WithErrorHandler(ErrorHandler),
+			runtime.WithMiddlewares(metrics.GatewayMiddleware, tracing.GatewayMiddleware),
 		)
-		
+
 		opts := []grpc.DialOption{
 			grpc.WithTransportCredentials(insecure.NewCredentials()),
+			tracing.ClientDialOption(), // Propagates the gateway span to the gRPC server
 		}
 		grpcEndpoint := "localhost:" + cfg.GRPCPort
 
 		// Register Services to Gateway
 		if err := pb.RegisterAuthServiceHandlerFromEndpoint(ctx, gwmux, grpcEndpoint, opts); err != nil {
-			errChan <- err; return
+			errChan <- err
+			return
 		}
 		if err := pb.RegisterUserServiceHandlerFromEndpoint(ctx, gwmux, grpcEndpoint, opts); err != nil {
-			errChan <- err; return
+			errChan <- err
+			return
 		}
 		if err := pb.RegisterHealthServiceHandlerFromEndpoint(ctx, gwmux, grpcEndpoint, opts); err != nil {
-			errChan <- err; return
+			errChan <- err
+			return
+		}
+		if err := pb.RegisterAuditServiceHandlerFromEndpoint(ctx, gwmux, grpcEndpoint, opts); err != nil {
+			errChan <- err
+			return
+		}
+		if err := pb.RegisterOperationServiceHandlerFromEndpoint(ctx, gwmux, grpcEndpoint, opts); err != nil {
+			errChan <- err
+			return
 		}
 
 		// Create a Root Mux to handle both Swagger and Gateway
 		mux := http.NewServeMux()
-		
+
 		// Mount Gateway (API)
-		mux.Handle("/", gwmux)
+		mux.Handle("/", RequestIDHandler(tracing.GatewayHandler(gwmux)))
 
 		// Mount Swagger
 		mux.HandleFunc("/swagger.json", swagger.ServeJSON)
 		mux.HandleFunc("/swagger-ui", swagger.ServeUI)
 
+		// Mount Probes
+		mux.HandleFunc("/livez", health.LivenessHandler())
+		mux.HandleFunc("/readyz", health.ReadinessHandler(healthMonitor))
+
 		logger.Log.Info("HTTP Gateway & Swagger listening", "port", cfg.GatewayPort)
 		if err := http.ListenAndServe(":"+cfg.GatewayPort, mux); err != nil {
 			errChan <- fmt.Errorf("gateway server error: %v", err)
 		}
 	}()
 
+	// --- Metrics ---
+	if cfg.MetricsAddr != "" {
+		go func() {
+			mux := http.NewServeMux()
+			mux.Handle("/metrics", metrics.Handler())
+
+			logger.Log.Info("Metrics listening", "addr", cfg.MetricsAddr)
+			if err := http.ListenAndServe(cfg.MetricsAddr, mux); err != nil {
+				errChan <- fmt.Errorf("metrics server error: %v", err)
+			}
+		}()
+	}
+
 	// 6. Shutdown
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
@@ -150,8 +293,16 @@ func main() {
 	select {
 	case <-quit:
 		logger.Log.Info("Shutting down servers...")
+		healthMonitor.Shutdown()
+		time.Sleep(cfg.Health.ShutdownDelay)
 		grpcServer.GracefulStop()
 	case err := <-errChan:
 		logger.Log.Error("Server failed", "error", err)
 	}
+
+	flushCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := shutdownTracing(flushCtx); err != nil {
+		logger.Log.Warn("Failed to flush traces", "error", err)
+	}
 }
\ No newline at end of file
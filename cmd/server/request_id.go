@@ -0,0 +1,24 @@
+package main
+
+import (
+	"net/http"
+
+	"starter-kit-grpc-golang/internal/interceptor"
+
+	"github.com/google/uuid"
+)
+
+// RequestIDHandler makes sure every gateway request has an X-Request-Id, generating one
+// if the client didn't send a valid one. The ID is forwarded to the gRPC server (see
+// HeaderMatcher) and echoed in the response, including error responses.
+func RequestIDHandler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id := r.Header.Get("X-Request-Id")
+		if !interceptor.ValidRequestID(id) {
+			id = uuid.NewString()
+			r.Header.Set("X-Request-Id", id)
+		}
+		w.Header().Set("X-Request-Id", id)
+		next.ServeHTTP(w, r)
+	})
+}
\ No newline at end of file
@@ -3,18 +3,40 @@ package config
 import (
 	"os"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/joho/godotenv"
 )
 
 type Config struct {
-	Env         string
-	GRPCPort    string
-	GatewayPort string // Port for the HTTP JSON Gateway
-	Database    DatabaseConfig
-	JWT         JWTConfig
-	SMTP        SMTPConfig
+	Env          string
+	GRPCPort     string
+	GatewayPort  string // Port for the HTTP JSON Gateway
+	MetricsAddr  string // Bind address for Prometheus /metrics, loopback by default. Empty disables it.
+	Database     DatabaseConfig
+	JWT          JWTConfig
+	SMTP         SMTPConfig
+	Registration RegistrationConfig
+	SoftDelete   SoftDeleteConfig
+	Operations   OperationsConfig
+	Watch        WatchConfig
+	Health       HealthConfig
+	Tracing      TracingConfig
+
+	// Full method names (e.g. "/v1.UserService/CreateUser") that require a verified email,
+	// in addition to methods annotated with (v1.requires_verified_email) in the protos
+	VerifiedEmailMethods []string
+
+	PageTokenSecret string // Signs list page tokens (defaults to the JWT secret)
+	AuditSecret     string // Keys the audit log hash chain (defaults to the JWT secret); keep it out of the database
+	MaxBatchSize    int    // Max items per Batch* RPC
+
+	IdempotencyTTL time.Duration // How long an Idempotency-Key replays its first response
+	RPCTimeout     time.Duration // Deadline for unary RPCs whose client sent none; 0 disables it
+
+	CrashReportFile string // Recovered panics are appended here as JSON lines; empty only logs them
+	LogPayloads     bool   // Log (redacted) request and response messages at debug level
 }
 
 type DatabaseConfig struct {
@@ -33,6 +55,7 @@ type JWTConfig struct {
 	RefreshExpiration       time.Duration
 	ResetPasswordExpiration time.Duration
 	VerifyEmailExpiration   time.Duration
+	InviteExpiration        time.Duration
 }
 
 type SMTPConfig struct {
@@ -43,15 +66,51 @@ type SMTPConfig struct {
 	From     string
 }
 
+type RegistrationConfig struct {
+	Mode           string   // "open" or "invite_only"
+	AllowedDomains []string // Empty means any domain (only used in "open" mode)
+}
+
+type SoftDeleteConfig struct {
+	Retention     time.Duration // How long soft-deleted users are kept before purge
+	PurgeInterval time.Duration // How often the purge job runs
+}
+
+type OperationsConfig struct {
+	Workers   int           // Long-running operations (import/export) processed concurrently
+	QueueSize int           // Pending operations beyond this are rejected
+	Retention time.Duration // How long finished operations stay queryable
+}
+
+type WatchConfig struct {
+	HistorySize      int // Recent user events kept so WatchUsers clients can resume
+	SubscriberBuffer int // Events queued per watcher before it is dropped as too slow
+}
+
+type HealthConfig struct {
+	Interval      time.Duration // How often dependency checks run
+	DiskPath      string        // Filesystem whose free space is checked
+	DiskMinFreeMB int           // Below this the instance reports NOT_SERVING
+	ShutdownDelay time.Duration // How long /readyz reports 503 before the servers stop, so load balancers can drain
+}
+
+type TracingConfig struct {
+	Exporter    string // "otlp", "stdout" or "none"
+	ServiceName string
+}
+
 // LoadConfig loads environment variables
 func LoadConfig() *Config {
 	// Attempt to load .env, ignore if not found (e.g. Docker)
 	_ = godotenv.Load()
 
+	jwtSecret := getEnv("JWT_SECRET", "super_secret_key_change_me")
+
 	return &Config{
 		Env:         getEnv("GO_ENV", "development"),
 		GRPCPort:    getEnv("GRPC_PORT", "50051"),
 		GatewayPort: getEnv("GATEWAY_PORT", "8080"),
+		MetricsAddr: getEnv("METRICS_ADDR", "127.0.0.1:9090"),
 		Database: DatabaseConfig{
 			Driver:   getEnv("DB_DRIVER", "sqlite"),
 			Host:     getEnv("DB_HOST", "localhost"),
@@ -62,11 +121,12 @@ func LoadConfig() *Config {
 			SSLMode:  getEnv("DB_SSLMODE", "disable"),
 		},
 		JWT: JWTConfig{
-			Secret:                  getEnv("JWT_SECRET", "super_secret_key_change_me"),
+			Secret:                  jwtSecret,
 			AccessExpiration:        time.Duration(getEnvAsInt("JWT_ACCESS_EXPIRATION_MINUTES", 30)) * time.Minute,
 			RefreshExpiration:       time.Duration(getEnvAsInt("JWT_REFRESH_EXPIRATION_DAYS", 30)) * 24 * time.Hour,
 			ResetPasswordExpiration: time.Duration(getEnvAsInt("JWT_RESET_PASSWORD_EXPIRATION_MINUTES", 15)) * time.Minute,
 			VerifyEmailExpiration:   time.Duration(getEnvAsInt("JWT_VERIFY_EMAIL_EXPIRATION_MINUTES", 15)) * time.Minute,
+			InviteExpiration:        time.Duration(getEnvAsInt("JWT_INVITE_EXPIRATION_HOURS", 72)) * time.Hour,
 		},
 		SMTP: SMTPConfig{
 			Host:     getEnv("SMTP_HOST", "smtp.example.com"),
@@ -75,6 +135,41 @@ func LoadConfig() *Config {
 			Password: getEnv("SMTP_PASSWORD", "pass"),
 			From:     getEnv("EMAIL_FROM", "no-reply@example.com"),
 		},
+		Registration: RegistrationConfig{
+			Mode:           getEnv("REGISTRATION_MODE", "open"),
+			AllowedDomains: getEnvAsSlice("REGISTRATION_ALLOWED_DOMAINS", nil),
+		},
+		VerifiedEmailMethods: getEnvAsSlice("EMAIL_VERIFICATION_REQUIRED_METHODS", nil),
+		PageTokenSecret:      getEnv("PAGE_TOKEN_SECRET", jwtSecret),
+		AuditSecret:          getEnv("AUDIT_CHAIN_SECRET", jwtSecret),
+		MaxBatchSize:         getEnvAsInt("BATCH_MAX_SIZE", 100),
+		IdempotencyTTL:       time.Duration(getEnvAsInt("IDEMPOTENCY_KEY_TTL_HOURS", 24)) * time.Hour,
+		RPCTimeout:           time.Duration(getEnvAsInt("RPC_DEFAULT_TIMEOUT_SECONDS", 30)) * time.Second,
+		CrashReportFile:      getEnv("CRASH_REPORT_FILE", "crash_reports.jsonl"),
+		LogPayloads:          getEnv("LOG_PAYLOADS", "false") == "true",
+		SoftDelete: SoftDeleteConfig{
+			Retention:     time.Duration(getEnvAsInt("USER_DELETE_RETENTION_DAYS", 30)) * 24 * time.Hour,
+			PurgeInterval: time.Duration(getEnvAsInt("USER_PURGE_INTERVAL_MINUTES", 60)) * time.Minute,
+		},
+		Operations: OperationsConfig{
+			Workers:   getEnvAsInt("OPERATION_WORKERS", 2),
+			QueueSize: getEnvAsInt("OPERATION_QUEUE_SIZE", 100),
+			Retention: time.Duration(getEnvAsInt("OPERATION_RETENTION_HOURS", 24)) * time.Hour,
+		},
+		Watch: WatchConfig{
+			HistorySize:      getEnvAsInt("WATCH_HISTORY_SIZE", 1000),
+			SubscriberBuffer: getEnvAsInt("WATCH_SUBSCRIBER_BUFFER", 256),
+		},
+		Tracing: TracingConfig{
+			Exporter:    getEnv("TRACING_EXPORTER", "none"),
+			ServiceName: getEnv("OTEL_SERVICE_NAME", "starter-kit-grpc-golang"),
+		},
+		Health: HealthConfig{
+			Interval:      time.Duration(getEnvAsInt("HEALTH_CHECK_INTERVAL_SECONDS", 10)) * time.Second,
+			DiskPath:      getEnv("HEALTH_DISK_PATH", "."),
+			DiskMinFreeMB: getEnvAsInt("HEALTH_DISK_MIN_FREE_MB", 100),
+			ShutdownDelay: time.Duration(getEnvAsInt("HEALTH_SHUTDOWN_DELAY_SECONDS", 0)) * time.Second,
+		},
 	}
 }
 
@@ -91,4 +186,20 @@ func getEnvAsInt(key string, fallback int) int {
 		return value
 	}
 	return fallback
+}
+
+// getEnvAsSlice reads a comma-separated list, trimming blanks
+func getEnvAsSlice(key string, fallback []string) []string {
+	valueStr := getEnv(key, "")
+	if valueStr == "" {
+		return fallback
+	}
+
+	var values []string
+	for _, v := range strings.Split(valueStr, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			values = append(values, v)
+		}
+	}
+	return values
 }
\ No newline at end of file
@@ -41,17 +41,29 @@ func ConnectDB(cfg *Config) {
 	}
 
 	DB, err = gorm.Open(dialector, &gorm.Config{
-		// Logger: logger.Default.LogMode(logger.Info), // Uncomment to see raw SQL
+		Logger: logger.NewGormLogger(),
+		// Logger: logger.NewGormLogger().LogMode(gormlogger.Info), // Uncomment to see raw SQL
 	})
 	if err != nil {
 		log.Fatalf("Failed to connect to database: %v", err)
 	}
 
-	// Auto Migrate (Create Tables)
-	err = DB.AutoMigrate(&models.User{}, &models.Token{})
-	if err != nil {
+	if err := Migrate(DB); err != nil {
 		log.Fatalf("Failed to migrate database: %v", err)
 	}
 
 	logger.Log.Info("Database connected and migrated successfully")
+}
+
+// Migrate creates or updates the tables and the user search index
+func Migrate(db *gorm.DB) error {
+	// Auto Migrate (Create Tables)
+	err := db.AutoMigrate(&models.User{}, &models.Token{}, &models.AuditEvent{}, &models.AuditChainHead{}, &models.IdempotencyKey{})
+	if err != nil {
+		return err
+	}
+	if err := migrateUserSearch(db); err != nil {
+		return fmt.Errorf("create user search index: %w", err)
+	}
+	return nil
 }
\ No newline at end of file
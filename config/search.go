@@ -0,0 +1,64 @@
+package config
+
+import (
+	"gorm.io/gorm"
+)
+
+// migrateUserSearch creates the full-text index behind user search.
+// The query side lives in repository/user_search.go and relies on these names.
+func migrateUserSearch(db *gorm.DB) error {
+	switch db.Dialector.Name() {
+	case "postgres":
+		return migrateUserSearchPostgres(db)
+	case "sqlite":
+		return migrateUserSearchSQLite(db)
+	}
+	return nil // Other drivers fall back to LIKE search
+}
+
+// Postgres: a generated tsvector (name weighted A, email B) with a GIN index.
+// Emails are also split on punctuation so "example" finds "bob@example.com".
+func migrateUserSearchPostgres(db *gorm.DB) error {
+	statements := []string{
+		`ALTER TABLE users ADD COLUMN IF NOT EXISTS search_vector tsvector
+			GENERATED ALWAYS AS (
+				setweight(to_tsvector('simple', coalesce(name, '')), 'A') ||
+				setweight(to_tsvector('simple', coalesce(email, '') || ' ' || translate(coalesce(email, ''), '@.-_+', '     ')), 'B')
+			) STORED`,
+		`CREATE INDEX IF NOT EXISTS idx_users_search_vector ON users USING GIN (search_vector)`,
+	}
+	for _, stmt := range statements {
+		if err := db.Exec(stmt).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// SQLite: an external-content FTS5 table kept in sync by triggers.
+// It is rebuilt on startup because users has no INTEGER PRIMARY KEY,
+// so VACUUM may renumber the rowids the index points at.
+func migrateUserSearchSQLite(db *gorm.DB) error {
+	statements := []string{
+		`CREATE VIRTUAL TABLE IF NOT EXISTS users_fts USING fts5(
+			name, email, content='users', content_rowid='rowid', prefix='2 3'
+		)`,
+		`CREATE TRIGGER IF NOT EXISTS users_fts_insert AFTER INSERT ON users BEGIN
+			INSERT INTO users_fts(rowid, name, email) VALUES (new.rowid, new.name, new.email);
+		END`,
+		`CREATE TRIGGER IF NOT EXISTS users_fts_delete AFTER DELETE ON users BEGIN
+			INSERT INTO users_fts(users_fts, rowid, name, email) VALUES ('delete', old.rowid, old.name, old.email);
+		END`,
+		`CREATE TRIGGER IF NOT EXISTS users_fts_update AFTER UPDATE OF name, email ON users BEGIN
+			INSERT INTO users_fts(users_fts, rowid, name, email) VALUES ('delete', old.rowid, old.name, old.email);
+			INSERT INTO users_fts(rowid, name, email) VALUES (new.rowid, new.name, new.email);
+		END`,
+		`INSERT INTO users_fts(users_fts) VALUES ('rebuild')`,
+	}
+	for _, stmt := range statements {
+		if err := db.Exec(stmt).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
\ No newline at end of file
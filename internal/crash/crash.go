@@ -0,0 +1,111 @@
+// Package crash records panics recovered from RPC handlers, so they can be matched to
+// the incident ID a client was given and investigated later
+package crash
+
+import (
+	"encoding/json"
+	"os"
+	"sync"
+	"time"
+
+	"starter-kit-grpc-golang/pkg/redact"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// Report describes one recovered panic
+type Report struct {
+	IncidentID string          `json:"incident_id"`
+	Time       time.Time       `json:"time"`
+	Method     string          `json:"method"`
+	RequestID  string          `json:"request_id,omitempty"`
+	Panic      string          `json:"panic"`
+	Stack      string          `json:"stack"`
+	Request    json.RawMessage `json:"request,omitempty"` // Sanitized, see SanitizeRequest
+	UserID     string          `json:"user_id,omitempty"`
+	Role       string          `json:"role,omitempty"`
+	Count      int64           `json:"count"` // Panics in this method since the server started
+}
+
+// Reporter stores crash reports
+type Reporter interface {
+	Report(report *Report) error
+}
+
+type fileReporter struct {
+	path string
+	mu   sync.Mutex
+}
+
+// NewFileReporter appends each report to path as one JSON line
+func NewFileReporter(path string) Reporter {
+	return &fileReporter{path: path}
+}
+
+func (r *fileReporter) Report(report *Report) error {
+	line, err := json.Marshal(report)
+	if err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	f, err := os.OpenFile(r.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(append(line, '\n')); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+type nopReporter struct{}
+
+// NewNopReporter discards reports; the panic is still logged by the interceptor
+func NewNopReporter() Reporter {
+	return nopReporter{}
+}
+
+func (nopReporter) Report(*Report) error { return nil }
+
+// Counter counts panics per method
+type Counter struct {
+	mu     sync.Mutex
+	counts map[string]int64
+}
+
+func NewCounter() *Counter {
+	return &Counter{counts: make(map[string]int64)}
+}
+
+// Inc records a panic in method and returns the method's new total
+func (c *Counter) Inc(method string) int64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.counts[method]++
+	return c.counts[method]
+}
+
+// Snapshot returns the current totals by method
+func (c *Counter) Snapshot() map[string]int64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	snapshot := make(map[string]int64, len(c.counts))
+	for method, n := range c.counts {
+		snapshot[method] = n
+	}
+	return snapshot
+}
+
+// SanitizeRequest renders msg as JSON with (v1.sensitive) fields redacted and emails masked
+func SanitizeRequest(msg proto.Message) json.RawMessage {
+	b, err := protojson.Marshal(redact.Message(msg))
+	if err != nil {
+		return nil
+	}
+	return b
+}
\ No newline at end of file
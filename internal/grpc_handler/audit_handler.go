@@ -0,0 +1,123 @@
+package grpc_handler
+
+import (
+	"context"
+
+	pb "starter-kit-grpc-golang/api/gen/v1"
+	"starter-kit-grpc-golang/internal/interceptor"
+	"starter-kit-grpc-golang/internal/models"
+	"starter-kit-grpc-golang/internal/repository"
+	"starter-kit-grpc-golang/internal/service"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type AuditHandler struct {
+	pb.UnimplementedAuditServiceServer
+	service service.AuditService
+}
+
+func NewAuditHandler(s service.AuditService) *AuditHandler {
+	return &AuditHandler{service: s}
+}
+
+// Helper to convert Model -> Proto
+func convertAuditEventToProto(e *models.AuditEvent) *pb.AuditEvent {
+	return &pb.AuditEvent{
+		Id:        uint64(e.ID),
+		EventType: e.EventType,
+		ActorId:   e.ActorID,
+		TargetId:  e.TargetID,
+		Ip:        e.IP,
+		UserAgent: e.UserAgent,
+		Outcome:   e.Outcome,
+		Details:   e.Details,
+		CreatedAt: timestamppb.New(e.CreatedAt),
+		PrevHash:  e.PrevHash,
+		Hash:      e.Hash,
+	}
+}
+
+// Helper to convert Proto filter -> Repository filter
+func convertAuditFilter(f *pb.AuditEventFilter) repository.AuditFilter {
+	filter := repository.AuditFilter{
+		ActorID:    f.GetActorId(),
+		TargetID:   f.GetTargetId(),
+		EventTypes: f.GetEventTypes(),
+	}
+	if f.GetStartTime() != nil {
+		filter.From = f.GetStartTime().AsTime()
+	}
+	if f.GetEndTime() != nil {
+		filter.To = f.GetEndTime().AsTime()
+	}
+	return filter
+}
+
+func (h *AuditHandler) ListEvents(ctx context.Context, req *pb.ListAuditEventsRequest) (*pb.ListAuditEventsResponse, error) {
+	// RBAC: Admin Only
+	if err := interceptor.AuthorizeAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	events, total, err := h.service.ListEvents(ctx, convertAuditFilter(req.Filter), req.Page, req.Limit)
+	if err != nil {
+		return nil, statusError(ctx, err)
+	}
+
+	var protoEvents []*pb.AuditEvent
+	for i := range events {
+		protoEvents = append(protoEvents, convertAuditEventToProto(&events[i]))
+	}
+
+	// Calculate Total Pages
+	limit := req.Limit
+	if limit < 1 {
+		limit = 10
+	}
+	totalPages := int32((total + int64(limit) - 1) / int64(limit))
+
+	return &pb.ListAuditEventsResponse{
+		Results:      protoEvents,
+		Page:         req.Page,
+		Limit:        req.Limit,
+		TotalPages:   totalPages,
+		TotalResults: total,
+	}, nil
+}
+
+func (h *AuditHandler) ExportEvents(req *pb.ExportAuditEventsRequest, stream pb.AuditService_ExportEventsServer) error {
+	// RBAC: Admin Only
+	if err := interceptor.AuthorizeAdmin(stream.Context()); err != nil {
+		return err
+	}
+
+	err := h.service.ExportEvents(stream.Context(), convertAuditFilter(req.Filter), func(event *models.AuditEvent) error {
+		if err := stream.Context().Err(); err != nil {
+			return err
+		}
+		return stream.Send(convertAuditEventToProto(event))
+	})
+	if err != nil {
+		return statusError(stream.Context(), err)
+	}
+	return nil
+}
+
+func (h *AuditHandler) VerifyChain(ctx context.Context, req *pb.VerifyAuditChainRequest) (*pb.VerifyAuditChainResponse, error) {
+	// RBAC: Admin Only
+	if err := interceptor.AuthorizeAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	result, err := h.service.VerifyChain(ctx)
+	if err != nil {
+		return nil, statusError(ctx, err)
+	}
+
+	return &pb.VerifyAuditChainResponse{
+		Valid:         result.Valid,
+		CheckedEvents: result.CheckedEvents,
+		BrokenEventId: uint64(result.BrokenEventID),
+	}, nil
+}
\ No newline at end of file
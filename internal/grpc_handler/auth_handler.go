@@ -25,9 +25,9 @@ func NewAuthHandler(s service.AuthService) *AuthHandler {
 }
 
 func (h *AuthHandler) Register(ctx context.Context, req *pb.RegisterRequest) (*pb.AuthResponse, error) {
-	user, accessToken, refreshToken, accessExp, refreshExp, err := h.service.Register(req.Name, req.Email, req.Password)
+	user, accessToken, refreshToken, accessExp, refreshExp, err := h.service.Register(ctx, req.Name, req.Email, req.Password)
 	if err != nil {
-		return nil, status.Error(codes.InvalidArgument, err.Error())
+		return nil, statusError(ctx, err)
 	}
 
 	// SET 201 CREATED
@@ -40,9 +40,9 @@ func (h *AuthHandler) Register(ctx context.Context, req *pb.RegisterRequest) (*p
 }
 
 func (h *AuthHandler) Login(ctx context.Context, req *pb.LoginRequest) (*pb.AuthResponse, error) {
-	user, accessToken, refreshToken, accessExp, refreshExp, err := h.service.Login(req.Email, req.Password)
+	user, accessToken, refreshToken, accessExp, refreshExp, err := h.service.Login(ctx, req.Email, req.Password)
 	if err != nil {
-		return nil, status.Error(codes.Unauthenticated, err.Error())
+		return nil, statusError(ctx, err)
 	}
 
 	return &pb.AuthResponse{
@@ -52,9 +52,9 @@ func (h *AuthHandler) Login(ctx context.Context, req *pb.LoginRequest) (*pb.Auth
 }
 
 func (h *AuthHandler) Logout(ctx context.Context, req *pb.LogoutRequest) (*pb.LogoutResponse, error) {
-	err := h.service.Logout(req.RefreshToken)
+	err := h.service.Logout(ctx, req.RefreshToken)
 	if err != nil {
-		return nil, status.Error(codes.NotFound, "invalid token")
+		return nil, statusError(ctx, err)
 	}
 
 	// SET 204 NO CONTENT
@@ -64,16 +64,16 @@ func (h *AuthHandler) Logout(ctx context.Context, req *pb.LogoutRequest) (*pb.Lo
 }
 
 func (h *AuthHandler) RefreshToken(ctx context.Context, req *pb.RefreshTokenRequest) (*pb.TokenPair, error) {
-	accessToken, refreshToken, accessExp, refreshExp, err := h.service.RefreshAuth(req.RefreshToken)
+	accessToken, refreshToken, accessExp, refreshExp, err := h.service.RefreshAuth(ctx, req.RefreshToken)
 	if err != nil {
-		return nil, status.Error(codes.Unauthenticated, err.Error())
+		return nil, statusError(ctx, err)
 	}
 
 	return createTokenPair(accessToken, refreshToken, accessExp, refreshExp), nil
 }
 
 func (h *AuthHandler) ForgotPassword(ctx context.Context, req *pb.ForgotPasswordRequest) (*pb.SuccessResponse, error) {
-	err := h.service.ForgotPassword(req.Email)
+	err := h.service.ForgotPassword(ctx, req.Email)
 	if err != nil {
 		return nil, status.Error(codes.Internal, "failed to process request")
 	}
@@ -82,9 +82,9 @@ func (h *AuthHandler) ForgotPassword(ctx context.Context, req *pb.ForgotPassword
 }
 
 func (h *AuthHandler) ResetPassword(ctx context.Context, req *pb.ResetPasswordRequest) (*pb.SuccessResponse, error) {
-	err := h.service.ResetPassword(req.Token, req.Password)
+	err := h.service.ResetPassword(ctx, req.Token, req.Password)
 	if err != nil {
-		return nil, status.Error(codes.InvalidArgument, err.Error())
+		return nil, statusError(ctx, err)
 	}
 	return &pb.SuccessResponse{Message: "Password reset successfully"}, nil
 }
@@ -95,19 +95,48 @@ func (h *AuthHandler) SendVerificationEmail(ctx context.Context, req *pb.Empty)
 		return nil, err
 	}
 
-	if err := h.service.SendVerificationEmail(userID); err != nil {
+	if err := h.service.SendVerificationEmail(ctx, userID); err != nil {
 		return nil, status.Error(codes.Internal, "failed to send email")
 	}
 	return &pb.SuccessResponse{Message: "Verification email sent"}, nil
 }
 
 func (h *AuthHandler) VerifyEmail(ctx context.Context, req *pb.VerifyEmailRequest) (*pb.SuccessResponse, error) {
-	if err := h.service.VerifyEmail(req.Token); err != nil {
-		return nil, status.Error(codes.InvalidArgument, err.Error())
+	if err := h.service.VerifyEmail(ctx, req.Token); err != nil {
+		return nil, statusError(ctx, err)
 	}
 	return &pb.SuccessResponse{Message: "Email verified successfully"}, nil
 }
 
+func (h *AuthHandler) InviteUser(ctx context.Context, req *pb.InviteUserRequest) (*pb.InviteUserResponse, error) {
+	// RBAC: Admin Only
+	if err := interceptor.AuthorizeAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	user, err := h.service.InviteUser(ctx, req.Email, req.Name, req.Role)
+	if err != nil {
+		return nil, statusError(ctx, err)
+	}
+
+	// SET 201 CREATED
+	grpc.SetHeader(ctx, metadata.Pairs("x-http-code", strconv.Itoa(201)))
+
+	return &pb.InviteUserResponse{User: convertUserToProto(user)}, nil
+}
+
+func (h *AuthHandler) AcceptInvite(ctx context.Context, req *pb.AcceptInviteRequest) (*pb.AuthResponse, error) {
+	user, accessToken, refreshToken, accessExp, refreshExp, err := h.service.AcceptInvite(ctx, req.Token, req.Name, req.Password)
+	if err != nil {
+		return nil, statusError(ctx, err)
+	}
+
+	return &pb.AuthResponse{
+		User:   convertUserToProto(user),
+		Tokens: createTokenPair(accessToken, refreshToken, accessExp, refreshExp),
+	}, nil
+}
+
 // Helper
 func createTokenPair(access, refresh string, accessExp, refreshExp time.Time) *pb.TokenPair {
 	return &pb.TokenPair{
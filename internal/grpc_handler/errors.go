@@ -0,0 +1,102 @@
+package grpc_handler
+
+import (
+	"context"
+	"errors"
+
+	"starter-kit-grpc-golang/internal/interceptor"
+	"starter-kit-grpc-golang/internal/service"
+	"starter-kit-grpc-golang/pkg/logger"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+var kindCodes = map[service.Kind]codes.Code{
+	service.KindInternal:           codes.Internal,
+	service.KindValidation:         codes.InvalidArgument,
+	service.KindNotFound:           codes.NotFound,
+	service.KindAlreadyExists:      codes.AlreadyExists,
+	service.KindConflict:           codes.Aborted,
+	service.KindFailedPrecondition: codes.FailedPrecondition,
+	service.KindPermissionDenied:   codes.PermissionDenied,
+	service.KindUnauthenticated:    codes.Unauthenticated,
+	service.KindResourceExhausted:  codes.ResourceExhausted,
+	service.KindOutOfRange:         codes.OutOfRange,
+}
+
+// statusError maps a service error to a gRPC status error. This is the one place
+// where domain errors become codes, so handlers should return statusError(err).
+func statusError(ctx context.Context, err error) error {
+	return toStatus(ctx, err).Err()
+}
+
+// toStatus converts err to a status:
+//   - typed service errors get their kind's code plus ErrorInfo, BadRequest and RetryInfo details
+//   - status errors (e.g. from interceptors) pass through
+//   - context errors keep their cancellation code
+//   - anything else is logged (with ctx's request logger) and reported as a bare Internal
+//     error, so internals don't leak
+func toStatus(ctx context.Context, err error) *status.Status {
+	var typed *service.Error
+	if errors.As(err, &typed) && typed.Kind != service.KindInternal {
+		return typedStatus(typed, err.Error())
+	}
+	if st, ok := status.FromError(err); ok {
+		return st
+	}
+	switch {
+	case errors.Is(err, context.Canceled):
+		return status.New(codes.Canceled, err.Error())
+	case errors.Is(err, context.DeadlineExceeded):
+		return status.New(codes.DeadlineExceeded, err.Error())
+	}
+
+	logger.FromContext(ctx).Error("Internal error", "error", err)
+	return status.New(codes.Internal, "internal error")
+}
+
+// typedStatus builds the status of a typed error. message is the full error text,
+// which may add context around the typed error's own message.
+func typedStatus(e *service.Error, message string) *status.Status {
+	code, ok := kindCodes[e.Kind]
+	if !ok {
+		code = codes.Internal
+	}
+	st := status.New(code, message)
+
+	details := []protoadapt.MessageV1{&errdetails.ErrorInfo{
+		Reason:   e.Reason,
+		Domain:   interceptor.ErrorDomain,
+		Metadata: e.Metadata,
+	}}
+
+	if len(e.Violations) > 0 {
+		badRequest := &errdetails.BadRequest{}
+		for _, v := range e.Violations {
+			description := v.Description
+			if description == "" {
+				description = message
+			}
+			badRequest.FieldViolations = append(badRequest.FieldViolations, &errdetails.BadRequest_FieldViolation{
+				Field:       v.Field,
+				Description: description,
+			})
+		}
+		details = append(details, badRequest)
+	}
+
+	// Only errors that know when a retry can succeed say so
+	if e.RetryAfter > 0 {
+		details = append(details, &errdetails.RetryInfo{RetryDelay: durationpb.New(e.RetryAfter)})
+	}
+
+	detailed, err := st.WithDetails(details...)
+	if err != nil {
+		return st
+	}
+	return detailed
+}
\ No newline at end of file
@@ -0,0 +1,45 @@
+package grpc_handler
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"starter-kit-grpc-golang/internal/service"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+)
+
+func TestToStatusRetryInfo(t *testing.T) {
+	tests := []struct {
+		name  string
+		err   *service.Error
+		code  codes.Code
+		retry time.Duration // 0: no RetryInfo
+	}{
+		{"conflict", &service.Error{Kind: service.KindConflict, Reason: "VERSION_CONFLICT"}, codes.Aborted, 0},
+		{"exhausted", &service.Error{Kind: service.KindResourceExhausted, Reason: "QUEUE_FULL"}, codes.ResourceExhausted, 0},
+		{"exhausted with delay", &service.Error{Kind: service.KindResourceExhausted, Reason: "QUEUE_FULL", RetryAfter: 30 * time.Second}, codes.ResourceExhausted, 30 * time.Second},
+		{"not found", &service.Error{Kind: service.KindNotFound, Reason: "USER_NOT_FOUND"}, codes.NotFound, 0},
+	}
+	for _, tt := range tests {
+		st := toStatus(context.Background(), tt.err)
+		if st.Code() != tt.code {
+			t.Errorf("%s: code %v, want %v", tt.name, st.Code(), tt.code)
+		}
+
+		var retry *errdetails.RetryInfo
+		for _, detail := range st.Details() {
+			if r, ok := detail.(*errdetails.RetryInfo); ok {
+				retry = r
+			}
+		}
+		switch {
+		case tt.retry == 0 && retry != nil:
+			t.Errorf("%s: RetryInfo %v, want none", tt.name, retry.GetRetryDelay().AsDuration())
+		case tt.retry != 0 && retry.GetRetryDelay().AsDuration() != tt.retry:
+			t.Errorf("%s: RetryInfo %v, want %v", tt.name, retry.GetRetryDelay().AsDuration(), tt.retry)
+		}
+	}
+}
\ No newline at end of file
@@ -2,21 +2,39 @@ package grpc_handler
 
 import (
 	"context"
+	"net/http"
+	"strconv"
 
 	pb "starter-kit-grpc-golang/api/gen/v1"
+	"starter-kit-grpc-golang/internal/health"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
 )
 
 type HealthHandler struct {
 	pb.UnimplementedHealthServiceServer
+	monitor *health.Monitor
 }
 
-func NewHealthHandler() *HealthHandler {
-	return &HealthHandler{}
+func NewHealthHandler(monitor *health.Monitor) *HealthHandler {
+	return &HealthHandler{monitor: monitor}
 }
 
 func (h *HealthHandler) HealthCheck(ctx context.Context, req *pb.HealthCheckRequest) (*pb.HealthCheckResponse, error) {
-	return &pb.HealthCheckResponse{
+	resp := &pb.HealthCheckResponse{
 		Status:  "healthy",
 		Message: "Server is running",
-	}, nil
+		Checks:  map[string]string{},
+	}
+	for _, result := range h.monitor.Results() {
+		resp.Checks[result.Name] = result.Status()
+	}
+
+	if !h.monitor.Ready() {
+		resp.Status = "unhealthy"
+		resp.Message = "Server is not ready to serve traffic"
+		grpc.SetHeader(ctx, metadata.Pairs("x-http-code", strconv.Itoa(http.StatusServiceUnavailable)))
+	}
+	return resp, nil
 }
\ No newline at end of file
@@ -0,0 +1,153 @@
+package grpc_handler
+
+import (
+	"context"
+
+	pb "starter-kit-grpc-golang/api/gen/v1"
+	"starter-kit-grpc-golang/internal/interceptor"
+	"starter-kit-grpc-golang/internal/service"
+
+	"cloud.google.com/go/longrunning/autogen/longrunningpb"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// OperationHandler serves both v1.OperationService (REST) and google.longrunning.Operations (gRPC).
+// DeleteOperation and WaitOperation stay unimplemented.
+type OperationHandler struct {
+	pb.UnimplementedOperationServiceServer
+	longrunningpb.UnimplementedOperationsServer
+	service service.OperationService
+}
+
+func NewOperationHandler(s service.OperationService) *OperationHandler {
+	return &OperationHandler{service: s}
+}
+
+// Helper to convert Operation -> Proto, packing kind-specific metadata and response
+func convertOperationToProto(ctx context.Context, op *service.Operation) (*longrunningpb.Operation, error) {
+	resp := &longrunningpb.Operation{Name: op.Name, Done: op.Done}
+
+	if metadata := convertOperationMetadata(op); metadata != nil {
+		packed, err := anypb.New(metadata)
+		if err != nil {
+			return nil, err
+		}
+		resp.Metadata = packed
+	}
+
+	if !op.Done {
+		return resp, nil
+	}
+	if op.Err != nil {
+		resp.Result = &longrunningpb.Operation_Error{Error: toStatus(ctx, op.Err).Proto()}
+		return resp, nil
+	}
+
+	if response := convertOperationResponse(op.Response); response != nil {
+		packed, err := anypb.New(response)
+		if err != nil {
+			return nil, err
+		}
+		resp.Result = &longrunningpb.Operation_Response{Response: packed}
+	}
+	return resp, nil
+}
+
+func convertOperationMetadata(op *service.Operation) proto.Message {
+	createTime := timestamppb.New(op.CreatedAt)
+	updateTime := timestamppb.New(op.UpdatedAt)
+
+	switch m := op.Metadata.(type) {
+	case *service.ImportProgress:
+		return &pb.ImportUsersMetadata{
+			TotalRows:     int32(m.TotalRows),
+			ProcessedRows: int32(m.ProcessedRows),
+			CreateTime:    createTime,
+			UpdateTime:    updateTime,
+		}
+	case *service.ExportProgress:
+		return &pb.ExportUsersMetadata{
+			ExportedRows: int32(m.ExportedRows),
+			CreateTime:   createTime,
+			UpdateTime:   updateTime,
+		}
+	}
+	return nil
+}
+
+func convertOperationResponse(response interface{}) proto.Message {
+	switch r := response.(type) {
+	case *service.ImportResult:
+		resp := &pb.ImportUsersResponse{
+			CreatedCount: int32(r.Created),
+			InvitedCount: int32(r.Invited),
+			FailedCount:  int32(len(r.Errors)),
+		}
+		for _, e := range r.Errors {
+			resp.Errors = append(resp.Errors, &pb.ImportRowError{Row: int32(e.Row), Email: e.Email, Message: e.Message})
+		}
+		return resp
+	case *service.ExportResult:
+		return &pb.ExportUsersResponse{Format: r.Format, UserCount: int32(r.Count), Content: r.Content}
+	}
+	return nil
+}
+
+func (h *OperationHandler) ListOperations(ctx context.Context, req *longrunningpb.ListOperationsRequest) (*longrunningpb.ListOperationsResponse, error) {
+	// RBAC: Admin Only
+	if err := interceptor.AuthorizeAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	if req.Filter != "" {
+		return nil, statusError(ctx, service.InvalidField("filter", "filter is not supported"))
+	}
+
+	ops, next, err := h.service.List(int(req.PageSize), req.PageToken)
+	if err != nil {
+		return nil, statusError(ctx, err)
+	}
+
+	resp := &longrunningpb.ListOperationsResponse{NextPageToken: next}
+	for i := range ops {
+		op, err := convertOperationToProto(ctx, &ops[i])
+		if err != nil {
+			return nil, statusError(ctx, err)
+		}
+		resp.Operations = append(resp.Operations, op)
+	}
+	return resp, nil
+}
+
+func (h *OperationHandler) GetOperation(ctx context.Context, req *longrunningpb.GetOperationRequest) (*longrunningpb.Operation, error) {
+	// RBAC: Admin Only
+	if err := interceptor.AuthorizeAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	op, err := h.service.Get(req.Name)
+	if err != nil {
+		return nil, statusError(ctx, err)
+	}
+
+	resp, err := convertOperationToProto(ctx, op)
+	if err != nil {
+		return nil, statusError(ctx, err)
+	}
+	return resp, nil
+}
+
+func (h *OperationHandler) CancelOperation(ctx context.Context, req *longrunningpb.CancelOperationRequest) (*emptypb.Empty, error) {
+	// RBAC: Admin Only
+	if err := interceptor.AuthorizeAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	if err := h.service.Cancel(req.Name); err != nil {
+		return nil, statusError(ctx, err)
+	}
+	return &emptypb.Empty{}, nil
+}
\ No newline at end of file
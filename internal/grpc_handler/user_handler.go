@@ -2,6 +2,7 @@ package grpc_handler
 
 import (
 	"context"
+	"fmt"
 	"strconv"
 
 	pb "starter-kit-grpc-golang/api/gen/v1"
@@ -9,33 +10,43 @@ import (
 	"starter-kit-grpc-golang/internal/models"
 	"starter-kit-grpc-golang/internal/service"
 
+	"cloud.google.com/go/longrunning/autogen/longrunningpb"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/reflect/protoreflect"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type UserHandler struct {
 	pb.UnimplementedUserServiceServer
 	service service.UserService
+	bulk    service.UserBulkService
 }
 
-func NewUserHandler(s service.UserService) *UserHandler {
-	return &UserHandler{service: s}
+func NewUserHandler(s service.UserService, bulk service.UserBulkService) *UserHandler {
+	return &UserHandler{service: s, bulk: bulk}
 }
 
 // Helper to convert Model -> Proto
 func convertUserToProto(u *models.User) *pb.UserResponse {
-	return &pb.UserResponse{
+	resp := &pb.UserResponse{
 		Id:              u.ID,
 		Name:            u.Name,
 		Email:           u.Email,
 		Role:            u.Role,
 		IsEmailVerified: u.IsEmailVerified,
+		Status:          u.Status,
+		StatusReason:    u.StatusReason,
 		CreatedAt:       timestamppb.New(u.CreatedAt),
 		UpdatedAt:       timestamppb.New(u.UpdatedAt),
+		Etag:            u.ETag(),
 	}
+	if u.DeletedAt.Valid {
+		resp.DeletedAt = timestamppb.New(u.DeletedAt.Time)
+	}
+	return resp
 }
 
 func (h *UserHandler) CreateUser(ctx context.Context, req *pb.CreateUserRequest) (*pb.UserResponse, error) {
@@ -44,9 +55,9 @@ func (h *UserHandler) CreateUser(ctx context.Context, req *pb.CreateUserRequest)
 		return nil, err
 	}
 
-	user, err := h.service.CreateUser(req.Name, req.Email, req.Password, req.Role)
+	user, err := h.service.CreateUser(ctx, req.Name, req.Email, req.Password, req.Role)
 	if err != nil {
-		return nil, status.Error(codes.InvalidArgument, err.Error())
+		return nil, statusError(ctx, err)
 	}
 
 	// SET 201 CREATED
@@ -61,11 +72,14 @@ func (h *UserHandler) GetUser(ctx context.Context, req *pb.GetUserRequest) (*pb.
 		return nil, err
 	}
 
-	user, err := h.service.GetUserByID(req.Id)
+	user, err := h.service.GetUserByID(ctx, req.Id)
 	if err != nil {
-		return nil, status.Error(codes.NotFound, "user not found")
+		return nil, statusError(ctx, err)
 	}
 
+	// Mirrored as an ETag header by the gateway
+	grpc.SetHeader(ctx, metadata.Pairs("etag", user.ETag()))
+
 	return convertUserToProto(user), nil
 }
 
@@ -75,17 +89,75 @@ func (h *UserHandler) ListUsers(ctx context.Context, req *pb.ListUsersRequest) (
 		return nil, err
 	}
 
-	filters := map[string]interface{}{
-		"search": req.Search,
-		"role":   req.Role,
-		"scope":  req.Scope,
+	query := service.UserQuery{
+		Search: req.Search,
+		Scope:  req.Scope,
+		Role:   req.Role,
+		Filter: req.Filter,
 	}
 
-	users, total, err := h.service.GetUsers(filters, req.Page, req.Limit, req.Sort)
+	page, err := h.service.GetUsers(ctx, query, service.PageParams{
+		Page:         req.Page,
+		Limit:        req.Limit,
+		Sort:         orderBy(req),
+		PageToken:    req.PageToken,
+		IncludeTotal: req.IncludeTotal,
+	})
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, statusError(ctx, err)
+	}
+
+	resp := buildListUsersResponse(page.Users, page.Total, req)
+	resp.NextPageToken = page.NextPageToken
+	return resp, nil
+}
+
+func (h *UserHandler) ListDeletedUsers(ctx context.Context, req *pb.ListUsersRequest) (*pb.ListUsersResponse, error) {
+	// RBAC: Admin Only
+	if err := interceptor.AuthorizeAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	if field := unsupportedDeletedUsersField(req); field != "" {
+		return nil, statusError(ctx, service.InvalidField(field, field+" is not supported when listing deleted users"))
+	}
+
+	users, total, err := h.service.GetDeletedUsers(ctx, req.Page, req.Limit, orderBy(req))
+	if err != nil {
+		return nil, statusError(ctx, err)
+	}
+
+	return buildListUsersResponse(users, total, req), nil
+}
+
+// unsupportedDeletedUsersField names the first ListUsersRequest field that
+// ListDeletedUsers doesn't support (it pages by number only), or returns ""
+func unsupportedDeletedUsersField(req *pb.ListUsersRequest) string {
+	switch {
+	case req.Search != "":
+		return "search"
+	case req.Role != "":
+		return "role"
+	case req.Scope != "":
+		return "scope"
+	case req.Filter != "":
+		return "filter"
+	case req.PageToken != "":
+		return "page_token"
 	}
+	return ""
+}
 
+// orderBy prefers the AIP-132 order_by over the legacy sort parameter
+func orderBy(req *pb.ListUsersRequest) string {
+	if req.OrderBy != "" {
+		return req.OrderBy
+	}
+	return req.Sort
+}
+
+// Helper to build a paginated list response
+func buildListUsersResponse(users []models.User, total int64, req *pb.ListUsersRequest) *pb.ListUsersResponse {
 	var protoUsers []*pb.UserResponse
 	for _, u := range users {
 		protoUsers = append(protoUsers, convertUserToProto(&u))
@@ -104,7 +176,7 @@ func (h *UserHandler) ListUsers(ctx context.Context, req *pb.ListUsersRequest) (
 		Limit:        req.Limit,
 		TotalPages:   totalPages,
 		TotalResults: total,
-	}, nil
+	}
 }
 
 func (h *UserHandler) UpdateUser(ctx context.Context, req *pb.UpdateUserRequest) (*pb.UserResponse, error) {
@@ -113,17 +185,21 @@ func (h *UserHandler) UpdateUser(ctx context.Context, req *pb.UpdateUserRequest)
 		return nil, err
 	}
 
-	dto := service.UpdateUserDTO{
-		Name:     req.Name,
-		Email:    req.Email,
-		Password: req.Password,
+	fields, err := updateUserFields(req)
+	if err != nil {
+		return nil, statusError(ctx, service.InvalidField("update_mask", err.Error()))
 	}
 
-	user, err := h.service.UpdateUser(req.Id, dto)
+	user, err := h.service.UpdateUser(ctx, req.Id, service.UpdateUserDTO{
+		Fields: fields,
+		ETag:   requestETag(ctx, req.Etag),
+	})
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, statusError(ctx, err)
 	}
 
+	grpc.SetHeader(ctx, metadata.Pairs("etag", user.ETag()))
+
 	return convertUserToProto(user), nil
 }
 
@@ -133,12 +209,249 @@ func (h *UserHandler) DeleteUser(ctx context.Context, req *pb.DeleteUserRequest)
 		return nil, err
 	}
 
-	if err := h.service.DeleteUser(req.Id); err != nil {
-		return nil, status.Error(codes.NotFound, err.Error())
+	if err := h.service.DeleteUser(ctx, req.Id, requestETag(ctx, req.Etag)); err != nil {
+		return nil, statusError(ctx, err)
 	}
 
 	// SET 204 NO CONTENT
 	grpc.SetHeader(ctx, metadata.Pairs("x-http-code", strconv.Itoa(204)))
 
 	return &pb.DeleteUserResponse{Success: true}, nil
+}
+
+// requestETag prefers the etag field and falls back to the If-Match header
+// (forwarded by the gateway as "if-match" metadata)
+func requestETag(ctx context.Context, etag string) string {
+	if etag != "" {
+		return etag
+	}
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if vals := md.Get("if-match"); len(vals) > 0 {
+			return vals[0]
+		}
+	}
+	return ""
+}
+
+func (h *UserHandler) BatchGetUsers(ctx context.Context, req *pb.BatchGetUsersRequest) (*pb.BatchUsersResponse, error) {
+	// RBAC: Admin Only
+	if err := interceptor.AuthorizeAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	results, err := h.service.BatchGetUsers(ctx, req.Ids, req.AllowPartial)
+	if err != nil {
+		return nil, statusError(ctx, err)
+	}
+	return buildBatchUsersResponse(ctx, results), nil
+}
+
+func (h *UserHandler) BatchCreateUsers(ctx context.Context, req *pb.BatchCreateUsersRequest) (*pb.BatchUsersResponse, error) {
+	// RBAC: Admin Only
+	if err := interceptor.AuthorizeAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	items := make([]service.CreateUserDTO, len(req.Requests))
+	for i, r := range req.Requests {
+		items[i] = service.CreateUserDTO{Name: r.Name, Email: r.Email, Password: r.Password, Role: r.Role}
+	}
+
+	results, err := h.service.BatchCreateUsers(ctx, items, req.AllowPartial)
+	if err != nil {
+		return nil, statusError(ctx, err)
+	}
+	return buildBatchUsersResponse(ctx, results), nil
+}
+
+func (h *UserHandler) BatchDeleteUsers(ctx context.Context, req *pb.BatchDeleteUsersRequest) (*pb.BatchUsersResponse, error) {
+	// RBAC: Admin Only
+	if err := interceptor.AuthorizeAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	results, err := h.service.BatchDeleteUsers(ctx, req.Ids, req.AllowPartial)
+	if err != nil {
+		return nil, statusError(ctx, err)
+	}
+	return buildBatchUsersResponse(ctx, results), nil
+}
+
+// Helper to build a batch response with a per-item status
+func buildBatchUsersResponse(ctx context.Context, results []service.BatchResult) *pb.BatchUsersResponse {
+	resp := &pb.BatchUsersResponse{Results: make([]*pb.BatchUserResult, len(results))}
+	for i, result := range results {
+		item := &pb.BatchUserResult{Status: status.New(codes.OK, "").Proto()}
+		if result.Err != nil {
+			item.Status = toStatus(ctx, result.Err).Proto()
+		} else if result.User != nil {
+			item.User = convertUserToProto(result.User)
+		}
+		resp.Results[i] = item
+	}
+	return resp
+}
+
+func (h *UserHandler) WatchUsers(req *pb.WatchUsersRequest, stream pb.UserService_WatchUsersServer) error {
+	// RBAC: Admin Only
+	if err := interceptor.AuthorizeAdmin(stream.Context()); err != nil {
+		return err
+	}
+
+	err := h.service.WatchUsers(stream.Context(), req.ResumeToken, func(event service.UserEvent, resumeToken string) error {
+		return stream.Send(&pb.UserEvent{
+			Type:        event.Type,
+			User:        convertUserEventToProto(event),
+			OccurredAt:  timestamppb.New(event.OccurredAt),
+			ResumeToken: resumeToken,
+		})
+	})
+
+	if err != nil {
+		return statusError(stream.Context(), err)
+	}
+	return nil
+}
+
+// Helper to convert an event's user; deleted events only carry the id
+func convertUserEventToProto(event service.UserEvent) *pb.UserResponse {
+	if event.Type == service.UserEventDeleted {
+		return &pb.UserResponse{Id: event.User.ID}
+	}
+	return convertUserToProto(&event.User)
+}
+
+func (h *UserHandler) ImportUsers(ctx context.Context, req *pb.ImportUsersRequest) (*longrunningpb.Operation, error) {
+	// RBAC: Admin Only
+	if err := interceptor.AuthorizeAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	op, err := h.bulk.ImportUsers(ctx, service.ImportUsersDTO{
+		Format:      req.Format,
+		Content:     req.Content,
+		SendInvites: req.SendInvites,
+	})
+	if err != nil {
+		return nil, statusError(ctx, err)
+	}
+	return convertOperationToProto(ctx, op)
+}
+
+func (h *UserHandler) ExportUsers(ctx context.Context, req *pb.ExportUsersRequest) (*longrunningpb.Operation, error) {
+	// RBAC: Admin Only
+	if err := interceptor.AuthorizeAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	op, err := h.bulk.ExportUsers(ctx, service.ExportUsersDTO{Format: req.Format, Filter: req.Filter})
+	if err != nil {
+		return nil, statusError(ctx, err)
+	}
+	return convertOperationToProto(ctx, op)
+}
+
+func (h *UserHandler) UndeleteUser(ctx context.Context, req *pb.UndeleteUserRequest) (*pb.UserResponse, error) {
+	// RBAC: Admin Only
+	if err := interceptor.AuthorizeAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	user, err := h.service.UndeleteUser(ctx, req.Id)
+	if err != nil {
+		return nil, statusError(ctx, err)
+	}
+
+	return convertUserToProto(user), nil
+}
+
+func (h *UserHandler) SuspendUser(ctx context.Context, req *pb.ChangeUserStatusRequest) (*pb.UserResponse, error) {
+	return h.changeUserStatus(ctx, req, models.UserStatusSuspended)
+}
+
+func (h *UserHandler) DeactivateUser(ctx context.Context, req *pb.ChangeUserStatusRequest) (*pb.UserResponse, error) {
+	return h.changeUserStatus(ctx, req, models.UserStatusDeactivated)
+}
+
+func (h *UserHandler) ReactivateUser(ctx context.Context, req *pb.ChangeUserStatusRequest) (*pb.UserResponse, error) {
+	return h.changeUserStatus(ctx, req, models.UserStatusActive)
+}
+
+func (h *UserHandler) changeUserStatus(ctx context.Context, req *pb.ChangeUserStatusRequest, newStatus string) (*pb.UserResponse, error) {
+	// RBAC: Admin Only
+	if err := interceptor.AuthorizeAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	// Prevent admins from locking themselves out
+	if userID, _ := interceptor.GetUserIDFromContext(ctx); userID == req.Id && newStatus != models.UserStatusActive {
+		return nil, status.Error(codes.FailedPrecondition, "cannot change your own account status")
+	}
+
+	user, err := h.service.ChangeUserStatus(ctx, req.Id, newStatus, req.Reason)
+	if err != nil {
+		return nil, statusError(ctx, err)
+	}
+
+	return convertUserToProto(user), nil
+}
+
+// updateUserFields resolves the update mask (AIP-134) into path -> value pairs
+func updateUserFields(req *pb.UpdateUserRequest) (map[string]string, error) {
+	fields := map[string]string{}
+
+	// Legacy request: top-level fields, empty means "not provided"
+	if req.User == nil && len(req.GetUpdateMask().GetPaths()) == 0 {
+		legacy := map[string]string{"name": req.Name, "email": req.Email, "password": req.Password}
+		for path, value := range legacy {
+			if value != "" {
+				fields[path] = value
+			}
+		}
+		return fields, nil
+	}
+
+	update := req.GetUser()
+	if update == nil {
+		update = &pb.UserUpdate{}
+	}
+	msg := update.ProtoReflect()
+
+	paths := req.GetUpdateMask().GetPaths()
+	switch {
+	case len(paths) == 1 && paths[0] == "*":
+		// Full replacement of every updatable field
+		paths = service.UpdatableUserFields()
+	case len(paths) == 0:
+		// No mask: apply every populated field
+		msg.Range(func(fd protoreflect.FieldDescriptor, _ protoreflect.Value) bool {
+			paths = append(paths, string(fd.Name()))
+			return true
+		})
+	default:
+		if !req.UpdateMask.IsValid(update) {
+			return nil, fmt.Errorf("update_mask contains unknown fields: %v", paths)
+		}
+	}
+
+	allowed := map[string]bool{}
+	for _, path := range service.UpdatableUserFields() {
+		allowed[path] = true
+	}
+
+	for _, path := range paths {
+		if !allowed[path] {
+			return nil, fmt.Errorf("field %q cannot be updated", path)
+		}
+		fd := msg.Descriptor().Fields().ByName(protoreflect.Name(path))
+		if fd == nil {
+			return nil, fmt.Errorf("field %q cannot be updated", path)
+		}
+		fields[path] = msg.Get(fd).String()
+	}
+
+	if len(fields) == 0 {
+		return nil, fmt.Errorf("no fields to update")
+	}
+	return fields, nil
 }
\ No newline at end of file
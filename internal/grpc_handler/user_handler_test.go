@@ -0,0 +1,75 @@
+package grpc_handler
+
+import (
+	"context"
+	"testing"
+
+	pb "starter-kit-grpc-golang/api/gen/v1"
+	"starter-kit-grpc-golang/internal/interceptor"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+func TestUpdateUserRejectsBadMask(t *testing.T) {
+	h := NewUserHandler(nil, nil) // The mask is rejected before the service is called
+	ctx := context.WithValue(context.Background(), interceptor.RoleKey, "admin")
+
+	for _, paths := range [][]string{{"nickname"}, {"role"}} {
+		_, err := h.UpdateUser(ctx, &pb.UpdateUserRequest{
+			Id:         "7c6f4c1e-7b0a-4d8e-9a0f-2a0b8f1f2a3b",
+			User:       &pb.UserUpdate{Name: "Alice"},
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: paths},
+		})
+		st := status.Convert(err)
+		if st.Code() != codes.InvalidArgument {
+			t.Errorf("mask %v: code %v, want InvalidArgument", paths, st.Code())
+			continue
+		}
+
+		if fields := violationFields(st); len(fields) != 1 || fields[0] != "update_mask" {
+			t.Errorf("mask %v: violations on %q, want update_mask", paths, violationFields(st))
+		}
+	}
+}
+
+func TestListDeletedUsersRejectsUnsupportedFields(t *testing.T) {
+	h := NewUserHandler(nil, nil) // Rejected before the service is called
+	ctx := context.WithValue(context.Background(), interceptor.RoleKey, "admin")
+
+	tests := []struct {
+		req   *pb.ListUsersRequest
+		field string
+	}{
+		{&pb.ListUsersRequest{Search: "ann"}, "search"},
+		{&pb.ListUsersRequest{Role: "admin"}, "role"},
+		{&pb.ListUsersRequest{Scope: "email"}, "scope"},
+		{&pb.ListUsersRequest{Filter: `role = "admin"`}, "filter"},
+		{&pb.ListUsersRequest{PageToken: "token"}, "page_token"},
+	}
+	for _, tt := range tests {
+		_, err := h.ListDeletedUsers(ctx, tt.req)
+		st := status.Convert(err)
+		if st.Code() != codes.InvalidArgument {
+			t.Errorf("%s: code %v, want InvalidArgument", tt.field, st.Code())
+			continue
+		}
+		if fields := violationFields(st); len(fields) != 1 || fields[0] != tt.field {
+			t.Errorf("%s: violations on %q", tt.field, fields)
+		}
+	}
+}
+
+func violationFields(st *status.Status) []string {
+	var fields []string
+	for _, detail := range st.Details() {
+		if br, ok := detail.(*errdetails.BadRequest); ok {
+			for _, v := range br.FieldViolations {
+				fields = append(fields, v.Field)
+			}
+		}
+	}
+	return fields
+}
\ No newline at end of file
@@ -0,0 +1,83 @@
+// Package health checks the server's dependencies and publishes the results through
+// the standard grpc.health.v1 service and the gateway's liveness/readiness endpoints
+package health
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// Checker probes one dependency. Check returns nil if it is usable.
+type Checker interface {
+	Name() string
+	Check(ctx context.Context) error
+}
+
+type dbChecker struct {
+	db *gorm.DB
+}
+
+// NewDBChecker pings the database connection pool
+func NewDBChecker(db *gorm.DB) Checker {
+	return &dbChecker{db: db}
+}
+
+func (c *dbChecker) Name() string { return "database" }
+
+func (c *dbChecker) Check(ctx context.Context) error {
+	sqlDB, err := c.db.DB()
+	if err != nil {
+		return err
+	}
+	return sqlDB.PingContext(ctx)
+}
+
+type smtpChecker struct {
+	addr string
+}
+
+// NewSMTPChecker opens (and closes) a TCP connection to the SMTP server
+func NewSMTPChecker(host string, port int) Checker {
+	return &smtpChecker{addr: net.JoinHostPort(host, fmt.Sprint(port))}
+}
+
+func (c *smtpChecker) Name() string { return "smtp" }
+
+func (c *smtpChecker) Check(ctx context.Context) error {
+	var d net.Dialer
+	conn, err := d.DialContext(ctx, "tcp", c.addr)
+	if err != nil {
+		return err
+	}
+	return conn.Close()
+}
+
+type diskChecker struct {
+	path    string
+	minFree uint64
+}
+
+// NewDiskChecker fails when the filesystem holding path has less than minFree bytes available
+func NewDiskChecker(path string, minFree uint64) Checker {
+	return &diskChecker{path: path, minFree: minFree}
+}
+
+func (c *diskChecker) Name() string { return "disk" }
+
+func (c *diskChecker) Check(ctx context.Context) error {
+	free, err := freeBytes(c.path)
+	if err != nil {
+		return err
+	}
+	if free < c.minFree {
+		return fmt.Errorf("%d MB free on %s, need %d MB", free>>20, c.path, c.minFree>>20)
+	}
+	return nil
+}
+
+// checkTimeout bounds a single check so one hung dependency can't stall the others
+const checkTimeout = 3 * time.Second
\ No newline at end of file
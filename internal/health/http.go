@@ -0,0 +1,44 @@
+package health
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+type probeResponse struct {
+	Status string            `json:"status"`
+	Checks map[string]string `json:"checks,omitempty"` // Checker name -> "ok" or "failing"
+}
+
+// LivenessHandler answers 200 while the process can serve HTTP at all. It deliberately
+// ignores dependencies: restarting the instance won't bring a database back.
+func LivenessHandler() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		writeProbe(w, http.StatusOK, probeResponse{Status: "ok"})
+	}
+}
+
+// ReadinessHandler answers 200 while the instance should receive traffic, and 503 when a
+// critical dependency is down or the server is shutting down
+func ReadinessHandler(m *Monitor) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		resp := probeResponse{Status: "ready", Checks: map[string]string{}}
+		for _, result := range m.Results() {
+			resp.Checks[result.Name] = result.Status()
+		}
+
+		code := http.StatusOK
+		if !m.Ready() {
+			code = http.StatusServiceUnavailable
+			resp.Status = "not ready"
+		}
+		writeProbe(w, code, resp)
+	}
+}
+
+func writeProbe(w http.ResponseWriter, code int, resp probeResponse) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(code)
+	json.NewEncoder(w).Encode(resp)
+}
\ No newline at end of file
@@ -0,0 +1,164 @@
+package health
+
+import (
+	"context"
+	"sync"
+
+	"starter-kit-grpc-golang/pkg/logger"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// Result is the outcome of one checker's latest run
+type Result struct {
+	Name     string
+	Critical bool   // A failing critical checker takes the server out of rotation
+	Error    string // Empty if the check passed
+}
+
+// Monitor runs the checkers and publishes the outcome on a grpc.health.v1 server.
+// The overall status ("") and every registered service are SERVING while all critical
+// checkers pass, and NOT_SERVING otherwise. Non-critical checkers are only reported.
+type Monitor struct {
+	server   *health.Server
+	services []string
+
+	mu       sync.RWMutex
+	checkers []entry
+	results  []Result
+	serving  bool
+	shutdown bool
+}
+
+// Status is "ok" or "failing". It is shown to unauthenticated callers, so the failure
+// itself (driver errors, hosts, paths) is only logged.
+func (r Result) Status() string {
+	if r.Error == "" {
+		return "ok"
+	}
+	return "failing"
+}
+
+type entry struct {
+	checker  Checker
+	critical bool
+}
+
+// NewMonitor creates a monitor that reports NOT_SERVING until the first Run
+func NewMonitor() *Monitor {
+	m := &Monitor{server: health.NewServer()}
+	m.publish(healthpb.HealthCheckResponse_NOT_SERVING)
+	return m
+}
+
+// Server is the grpc.health.v1.Health implementation to register on the gRPC server
+func (m *Monitor) Server() healthpb.HealthServer {
+	return m.server
+}
+
+// SetServices publishes a status for each service registered on the gRPC server, so
+// clients can check "v1.UserService" as well as the overall "" status. Call it before the first Run.
+func (m *Monitor) SetServices(services map[string]grpc.ServiceInfo) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.services = m.services[:0]
+	for name := range services {
+		m.services = append(m.services, name)
+	}
+	m.publish(healthpb.HealthCheckResponse_NOT_SERVING)
+}
+
+// Register adds a checker. Call it before the first Run.
+func (m *Monitor) Register(checker Checker, critical bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.checkers = append(m.checkers, entry{checker: checker, critical: critical})
+}
+
+// Run executes every checker concurrently and publishes the new status
+func (m *Monitor) Run(ctx context.Context) {
+	m.mu.RLock()
+	checkers := m.checkers
+	m.mu.RUnlock()
+
+	results := make([]Result, len(checkers))
+	var wg sync.WaitGroup
+	for i, e := range checkers {
+		wg.Add(1)
+		go func(i int, e entry) {
+			defer wg.Done()
+			checkCtx, cancel := context.WithTimeout(ctx, checkTimeout)
+			defer cancel()
+
+			results[i] = Result{Name: e.checker.Name(), Critical: e.critical}
+			if err := e.checker.Check(checkCtx); err != nil {
+				results[i].Error = err.Error()
+			}
+		}(i, e)
+	}
+	wg.Wait()
+
+	serving := true
+	for _, r := range results {
+		if r.Critical && r.Error != "" {
+			serving = false
+		}
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.shutdown {
+		return
+	}
+	for _, r := range results {
+		if r.Error != "" {
+			logger.Log.Warn("Health check failed", "checker", r.Name, "critical", r.Critical, "error", r.Error)
+		}
+	}
+	if serving != m.serving || m.results == nil {
+		logger.Log.Info("Health status changed", "serving", serving)
+	}
+	m.results = results
+	m.serving = serving
+
+	if serving {
+		m.publish(healthpb.HealthCheckResponse_SERVING)
+	} else {
+		m.publish(healthpb.HealthCheckResponse_NOT_SERVING)
+	}
+}
+
+// Shutdown marks everything NOT_SERVING for good, so load balancers stop routing to
+// this instance while in-flight requests drain
+func (m *Monitor) Shutdown() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.shutdown = true
+	m.serving = false
+	m.server.Shutdown()
+}
+
+// Ready reports whether the server should receive traffic
+func (m *Monitor) Ready() bool {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.serving
+}
+
+// Results returns the latest result of every checker
+func (m *Monitor) Results() []Result {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return append([]Result(nil), m.results...)
+}
+
+// publish sets the overall status and the status of every service. Callers hold mu
+// (or own m exclusively).
+func (m *Monitor) publish(status healthpb.HealthCheckResponse_ServingStatus) {
+	m.server.SetServingStatus("", status)
+	for _, service := range m.services {
+		m.server.SetServingStatus(service, status)
+	}
+}
\ No newline at end of file
@@ -0,0 +1,68 @@
+package interceptor
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"starter-kit-grpc-golang/internal/repository"
+)
+
+const (
+	// accountStatusTTL bounds how long a looked-up account status is reused, and so how
+	// long a suspended account keeps working
+	accountStatusTTL = 5 * time.Second
+
+	// maxAccountStatuses triggers a sweep of expired entries
+	maxAccountStatuses = 10000
+)
+
+// accountStatuses caches account statuses for authenticate, so a logged-in client
+// doesn't cost a database query on every call
+type accountStatuses struct {
+	repo repository.UserRepository
+	ttl  time.Duration
+
+	mu      sync.Mutex
+	entries map[string]accountStatusEntry
+}
+
+type accountStatusEntry struct {
+	status  string
+	expires time.Time
+}
+
+func newAccountStatuses(repo repository.UserRepository, ttl time.Duration) *accountStatuses {
+	return &accountStatuses{repo: repo, ttl: ttl, entries: make(map[string]accountStatusEntry)}
+}
+
+// get returns the status of the user, from the cache if it was looked up recently.
+// Errors are not cached.
+func (a *accountStatuses) get(ctx context.Context, userID string) (string, error) {
+	now := time.Now()
+	a.mu.Lock()
+	entry, ok := a.entries[userID]
+	a.mu.Unlock()
+	if ok && now.Before(entry.expires) {
+		return entry.status, nil
+	}
+
+	status, err := a.repo.FindStatusByID(ctx, userID)
+	if err != nil {
+		return "", err
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if len(a.entries) >= maxAccountStatuses {
+		for id, e := range a.entries {
+			if !now.Before(e.expires) {
+				delete(a.entries, id)
+			}
+		}
+	}
+	if len(a.entries) < maxAccountStatuses {
+		a.entries[userID] = accountStatusEntry{status: status, expires: now.Add(a.ttl)}
+	}
+	return status, nil
+}
\ No newline at end of file
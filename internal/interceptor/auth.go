@@ -2,76 +2,168 @@ package interceptor
 
 import (
 	"context"
+	"errors"
 	"strings"
 
 	"starter-kit-grpc-golang/config"
+	"starter-kit-grpc-golang/internal/models"
+	"starter-kit-grpc-golang/internal/repository"
+	"starter-kit-grpc-golang/internal/requestctx"
+	"starter-kit-grpc-golang/pkg/logger"
 	"starter-kit-grpc-golang/pkg/utils"
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
 )
 
 type contextKey string
 
+// Context keys of the authenticated caller, defined in requestctx so services can read them
 const (
-	UserIDKey contextKey = "userID"
-	RoleKey   contextKey = "role"
+	UserIDKey        = requestctx.UserIDKey
+	RoleKey          = requestctx.RoleKey
+	EmailVerifiedKey = requestctx.EmailVerifiedKey
 )
 
+// publicMethods skip authentication
+// Format: /<package>.<Service>/<Method>
+var publicMethods = map[string]bool{
+	"/v1.AuthService/Login":          true,
+	"/v1.AuthService/Register":       true,
+	"/v1.AuthService/RefreshToken":   true,
+	"/v1.AuthService/ForgotPassword": true,
+	"/v1.AuthService/ResetPassword":  true,
+	"/v1.AuthService/VerifyEmail":    true,
+	"/v1.AuthService/AcceptInvite":   true,
+	"/v1.HealthService/HealthCheck":  true,
+	"/grpc.health.v1.Health/Check":   true,
+	"/grpc.health.v1.Health/List":    true,
+	"/grpc.health.v1.Health/Watch":   true,
+}
+
 // AuthInterceptor creates a unary server interceptor for JWT validation
-func AuthInterceptor(cfg *config.Config) grpc.UnaryServerInterceptor {
+func AuthInterceptor(cfg *config.Config, userRepo repository.UserRepository) grpc.UnaryServerInterceptor {
+	statuses := newAccountStatuses(userRepo, accountStatusTTL)
 	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
-		// 1. Define Public Methods (Skip Auth)
-		// Format: /<package>.<Service>/<Method>
-		publicMethods := map[string]bool{
-			"/v1.AuthService/Login":                 true,
-			"/v1.AuthService/Register":              true,
-			"/v1.AuthService/RefreshToken":          true,
-			"/v1.AuthService/ForgotPassword":        true,
-			"/v1.AuthService/ResetPassword":         true,
-			"/v1.AuthService/VerifyEmail":           true,
-			"/v1.HealthService/HealthCheck":         true,
-		}
-
 		if publicMethods[info.FullMethod] {
 			return handler(ctx, req)
 		}
 
-		// 2. Extract Token from Metadata
-		md, ok := metadata.FromIncomingContext(ctx)
-		if !ok {
-			return nil, status.Error(codes.Unauthenticated, "metadata is not provided")
+		ctx, err := authenticate(ctx, cfg, statuses)
+		if err != nil {
+			return nil, err
 		}
 
-		authHeader := md["authorization"]
-		if len(authHeader) == 0 {
-			return nil, status.Error(codes.Unauthenticated, "authorization token is not provided")
-		}
+		return handler(ctx, req)
+	}
+}
 
-		// Expected format: "Bearer <token>"
-		tokenParts := strings.Split(authHeader[0], " ")
-		if len(tokenParts) != 2 || tokenParts[0] != "Bearer" {
-			return nil, status.Error(codes.Unauthenticated, "invalid token format")
+// StreamAuthInterceptor creates a stream server interceptor for JWT validation
+func StreamAuthInterceptor(cfg *config.Config, userRepo repository.UserRepository) grpc.StreamServerInterceptor {
+	statuses := newAccountStatuses(userRepo, accountStatusTTL)
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if publicMethods[info.FullMethod] {
+			return handler(srv, ss)
 		}
 
-		tokenString := tokenParts[1]
-
-		// 3. Validate Token
-		claims, err := utils.ValidateToken(tokenString, cfg.JWT.Secret)
+		ctx, err := authenticate(ss.Context(), cfg, statuses)
 		if err != nil {
-			return nil, status.Error(codes.Unauthenticated, "invalid or expired token")
+			return err
 		}
 
-		if claims.Type != "access" {
-			return nil, status.Error(codes.Unauthenticated, "invalid token type")
-		}
+		return handler(srv, &wrappedServerStream{ServerStream: ss, ctx: ctx})
+	}
+}
 
-		// 4. Inject Claims into Context
-		ctx = context.WithValue(ctx, UserIDKey, claims.UserID)
-		ctx = context.WithValue(ctx, RoleKey, claims.Role)
+// authenticate validates the bearer token and returns a context carrying its claims
+func authenticate(ctx context.Context, cfg *config.Config, statuses *accountStatuses) (context.Context, error) {
+	// 1. Extract Token from Metadata
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "metadata is not provided")
+	}
 
-		return handler(ctx, req)
+	authHeader := md["authorization"]
+	if len(authHeader) == 0 {
+		return nil, status.Error(codes.Unauthenticated, "authorization token is not provided")
+	}
+
+	// Expected format: "Bearer <token>"
+	tokenParts := strings.Split(authHeader[0], " ")
+	if len(tokenParts) != 2 || tokenParts[0] != "Bearer" {
+		return nil, status.Error(codes.Unauthenticated, "invalid token format")
+	}
+
+	tokenString := tokenParts[1]
+
+	// 2. Validate Token
+	claims, err := utils.ValidateToken(tokenString, cfg.JWT.Secret)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "invalid or expired token")
 	}
+
+	if claims.Type != "access" {
+		return nil, status.Error(codes.Unauthenticated, "invalid token type")
+	}
+
+	// 3. Reject Suspended/Deactivated Accounts (access tokens outlive revoked sessions)
+	accountStatus, err := statuses.get(ctx, claims.UserID)
+	if ctxErr := ctx.Err(); ctxErr != nil {
+		return nil, status.FromContextError(ctxErr).Err()
+	}
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, status.Error(codes.Unauthenticated, "user not found")
+	}
+	if err != nil {
+		// Not Unauthenticated: clients would discard tokens that are still valid
+		logger.FromContext(ctx).Error("Failed to look up account status", "error", err)
+		return nil, status.Error(codes.Unavailable, "could not verify the account, try again")
+	}
+	if accountStatus != "" && accountStatus != models.UserStatusActive {
+		return nil, status.Errorf(codes.PermissionDenied, "account is %s", accountStatus)
+	}
+
+	// 4. Inject Claims into Context
+	ctx = context.WithValue(ctx, UserIDKey, claims.UserID)
+	ctx = context.WithValue(ctx, RoleKey, claims.Role)
+	ctx = context.WithValue(ctx, EmailVerifiedKey, claims.EmailVerified)
+
+	ctx = logger.With(ctx, "user_id", claims.UserID)
+
+	// Let interceptors that ran before authentication (e.g. recovery) see the caller
+	if c, ok := ctx.Value(callerKey).(*caller); ok {
+		c.userID, c.role = claims.UserID, claims.Role
+	}
+
+	return ctx, nil
+}
+
+// caller is filled in by authenticate, for interceptors that run before it
+type caller struct {
+	userID string
+	role   string
+}
+
+const callerKey contextKey = "caller"
+
+// withCaller returns ctx's caller, adding an empty one if there is none yet
+func withCaller(ctx context.Context) (context.Context, *caller) {
+	if c, ok := ctx.Value(callerKey).(*caller); ok {
+		return ctx, c
+	}
+	c := &caller{}
+	return context.WithValue(ctx, callerKey, c), c
+}
+
+// wrappedServerStream overrides the stream context so handlers see injected values
+type wrappedServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (w *wrappedServerStream) Context() context.Context {
+	return w.ctx
 }
\ No newline at end of file
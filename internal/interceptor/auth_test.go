@@ -0,0 +1,100 @@
+package interceptor
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"starter-kit-grpc-golang/config"
+	"starter-kit-grpc-golang/internal/models"
+	"starter-kit-grpc-golang/internal/repository"
+	"starter-kit-grpc-golang/pkg/utils"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+// statusRepo answers FindStatusByID and counts the lookups
+type statusRepo struct {
+	repository.UserRepository
+	status  string
+	err     error
+	lookups int
+}
+
+func (r *statusRepo) FindStatusByID(ctx context.Context, id string) (string, error) {
+	r.lookups++
+	return r.status, r.err
+}
+
+func authenticatedCall(t *testing.T, interceptor grpc.UnaryServerInterceptor, secret string) error {
+	t.Helper()
+	token, _, err := utils.GenerateToken("user-1", "user", "access", time.Minute, secret)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer "+token))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) { return nil, nil }
+	_, err = interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: "/v1.UserService/GetUser"}, handler)
+	return err
+}
+
+func TestAuthAccountStatus(t *testing.T) {
+	cfg := &config.Config{JWT: config.JWTConfig{Secret: "secret"}}
+
+	tests := []struct {
+		name   string
+		status string
+		err    error
+		want   codes.Code
+	}{
+		{"active", models.UserStatusActive, nil, codes.OK},
+		{"suspended", models.UserStatusSuspended, nil, codes.PermissionDenied},
+		{"deleted", "", gorm.ErrRecordNotFound, codes.Unauthenticated},
+		// A database blip must not look like a revoked session
+		{"database down", "", errors.New("connection refused"), codes.Unavailable},
+	}
+	for _, tt := range tests {
+		repo := &statusRepo{status: tt.status, err: tt.err}
+		err := authenticatedCall(t, AuthInterceptor(cfg, repo), cfg.JWT.Secret)
+		if code := status.Code(err); code != tt.want {
+			t.Errorf("%s: code %v, want %v (%v)", tt.name, code, tt.want, err)
+		}
+	}
+}
+
+func TestAccountStatusesCache(t *testing.T) {
+	repo := &statusRepo{status: models.UserStatusActive}
+	statuses := newAccountStatuses(repo, time.Hour)
+
+	for i := 0; i < 3; i++ {
+		if got, err := statuses.get(context.Background(), "user-1"); err != nil || got != models.UserStatusActive {
+			t.Fatalf("get = %q, %v", got, err)
+		}
+	}
+	if repo.lookups != 1 {
+		t.Errorf("%d lookups, want 1 while cached", repo.lookups)
+	}
+
+	// Failures are not cached
+	repo.err = errors.New("connection refused")
+	if _, err := statuses.get(context.Background(), "user-2"); err == nil {
+		t.Fatal("want the lookup error")
+	}
+	repo.err = nil
+	if _, err := statuses.get(context.Background(), "user-2"); err != nil {
+		t.Errorf("lookup after a failure: %v", err)
+	}
+
+	expired := newAccountStatuses(repo, 0)
+	repo.lookups = 0
+	expired.get(context.Background(), "user-1")
+	expired.get(context.Background(), "user-1")
+	if repo.lookups != 2 {
+		t.Errorf("%d lookups with no TTL, want 2", repo.lookups)
+	}
+}
\ No newline at end of file
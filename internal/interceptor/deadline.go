@@ -0,0 +1,28 @@
+package interceptor
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc"
+)
+
+// DeadlineInterceptor gives unary calls that arrive without a deadline a server-side one
+// of timeout, so a forgotten client timeout can't keep DB queries running indefinitely.
+// Deadlines sent by the client (grpc-timeout) are kept as they are. A zero timeout disables it.
+// Must run first, so authentication lookups are bounded too.
+// There is no stream variant: streams such as WatchUsers are meant to stay open.
+func DeadlineInterceptor(timeout time.Duration) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if timeout <= 0 {
+			return handler(ctx, req)
+		}
+		if _, ok := ctx.Deadline(); ok {
+			return handler(ctx, req)
+		}
+
+		ctx, cancel := context.WithTimeout(ctx, timeout)
+		defer cancel()
+		return handler(ctx, req)
+	}
+}
\ No newline at end of file
@@ -0,0 +1,246 @@
+package interceptor
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"starter-kit-grpc-golang/internal/models"
+	"starter-kit-grpc-golang/internal/repository"
+	"starter-kit-grpc-golang/pkg/logger"
+	"starter-kit-grpc-golang/pkg/redact"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+const (
+	IdempotencyKeyHeader      = "idempotency-key"
+	idempotencyReplayedHeader = "idempotency-replayed"
+	maxIdempotencyKeyLength   = 255
+
+	// defaultIdempotencyLease bounds a pending reservation for requests without a deadline
+	defaultIdempotencyLease = time.Minute
+)
+
+// idempotentMethods are the mutating RPCs whose responses may be stored and replayed.
+// Auth RPCs are left out on purpose: their responses carry live tokens, and a replayed
+// refresh token would outlive its rotation. Other methods ignore the header.
+var idempotentMethods = map[string]bool{
+	"/v1.UserService/CreateUser":           true,
+	"/v1.UserService/BatchCreateUsers":     true,
+	"/v1.UserService/BatchDeleteUsers":     true,
+	"/v1.UserService/ImportUsers":          true,
+	"/v1.UserService/ExportUsers":          true,
+	"/v1.UserService/UpdateUser":           true,
+	"/v1.UserService/DeleteUser":           true,
+	"/v1.UserService/UndeleteUser":         true,
+	"/v1.UserService/SuspendUser":          true,
+	"/v1.UserService/DeactivateUser":       true,
+	"/v1.UserService/ReactivateUser":       true,
+	"/v1.OperationService/CancelOperation": true,
+}
+
+// IdempotencyInterceptor makes requests carrying an Idempotency-Key safe to retry.
+// The first successful response is stored for ttl; a retry with the same key and the
+// same request replays it without running the handler again. Reusing a key for a
+// different request is rejected. Failed requests release the key.
+// While the handler runs, the key is only leased until the request's deadline, so a
+// retry can take it over if the server died mid-request.
+// Only methods in idempotentMethods take part, and responses with (v1.sensitive) fields
+// are never stored.
+// Must run after AuthInterceptor, since keys are scoped to the caller, and after
+// DeadlineInterceptor, which bounds the lease.
+// There is no stream variant: a stream's responses can't be replayed as one stored message.
+func IdempotencyInterceptor(repo repository.IdempotencyRepository, ttl time.Duration) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if !idempotentMethods[info.FullMethod] {
+			return handler(ctx, req)
+		}
+		md, _ := metadata.FromIncomingContext(ctx)
+		keys := md.Get(IdempotencyKeyHeader)
+		if len(keys) == 0 {
+			return handler(ctx, req)
+		}
+
+		// 1. Validate the key and fingerprint the request
+		key := strings.TrimSpace(keys[0])
+		if key == "" || len(key) > maxIdempotencyKeyLength {
+			return nil, status.Errorf(codes.InvalidArgument, "idempotency key must be 1-%d characters", maxIdempotencyKeyLength)
+		}
+		msg, ok := req.(proto.Message)
+		if !ok {
+			return handler(ctx, req)
+		}
+		fingerprint, err := requestFingerprint(msg)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		// 2. Reserve the key, or find the earlier request that holds it
+		userID, _ := ctx.Value(UserIDKey).(string)
+		record := &models.IdempotencyKey{
+			ID:          hashParts(userID, info.FullMethod, key),
+			Method:      info.FullMethod,
+			Fingerprint: fingerprint,
+			Status:      models.IdempotencyPending,
+			LeaseID:     uuid.NewString(),
+			ExpiresAt:   pendingUntil(ctx),
+		}
+		existing, err := repo.Reserve(ctx, record)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		if existing != nil {
+			return replay(ctx, info.FullMethod, existing, fingerprint)
+		}
+
+		// 3. Run the handler, recording the headers it sets (e.g. x-http-code)
+		recorder := &headerRecorder{ServerTransportStream: grpc.ServerTransportStreamFromContext(ctx)}
+		if recorder.ServerTransportStream != nil {
+			ctx = grpc.NewContextWithServerTransportStream(ctx, recorder)
+		}
+
+		resp, err := handler(ctx, req)
+
+		// The outcome must be stored (or released) even if the client has gone away meanwhile
+		storeCtx := context.WithoutCancel(ctx)
+		if err != nil {
+			if releaseErr := repo.Release(storeCtx, record); releaseErr != nil {
+				logger.FromContext(ctx).Warn("Failed to release idempotency key", "error", releaseErr)
+			}
+			return resp, err
+		}
+
+		// 4. Store the outcome. The response is already committed, so a failure is only logged,
+		// and the key released rather than left pending
+		if err := complete(storeCtx, repo, record, resp, recorder.header, time.Now().Add(ttl)); err != nil {
+			logger.FromContext(ctx).Warn("Failed to store idempotent response", "error", err)
+			if releaseErr := repo.Release(storeCtx, record); releaseErr != nil {
+				logger.FromContext(ctx).Warn("Failed to release idempotency key", "error", releaseErr)
+			}
+		}
+		return resp, nil
+	}
+}
+
+// replay answers a retry from the stored record
+func replay(ctx context.Context, fullMethod string, record *models.IdempotencyKey, fingerprint string) (interface{}, error) {
+	if record.Fingerprint != fingerprint {
+		return nil, status.Error(codes.FailedPrecondition, "idempotency key was already used for a different request")
+	}
+	if record.Status != models.IdempotencyCompleted {
+		return nil, status.Error(codes.Aborted, "a request with this idempotency key is still in progress")
+	}
+
+	resp, err := newResponse(fullMethod)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if err := proto.Unmarshal(record.Response, resp); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	header := metadata.MD{}
+	if record.Headers != "" {
+		if err := json.Unmarshal([]byte(record.Headers), &header); err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+	if header == nil {
+		header = metadata.MD{} // Stored as "null" when the handler set no headers
+	}
+	header.Set(idempotencyReplayedHeader, "true")
+	grpc.SetHeader(ctx, header)
+
+	return resp, nil
+}
+
+// pendingUntil is when a reservation lapses if its request never finishes
+func pendingUntil(ctx context.Context) time.Time {
+	if deadline, ok := ctx.Deadline(); ok {
+		return deadline
+	}
+	return time.Now().Add(defaultIdempotencyLease)
+}
+
+// complete stores resp for replays. Responses carrying secrets are not kept; the key is
+// released instead, so a retry runs the handler again.
+func complete(ctx context.Context, repo repository.IdempotencyRepository, record *models.IdempotencyKey, resp interface{}, header metadata.MD, expiresAt time.Time) error {
+	msg, ok := resp.(proto.Message)
+	if !ok || redact.HasSensitive(msg) {
+		return repo.Release(ctx, record)
+	}
+	response, err := proto.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	headers, err := json.Marshal(header)
+	if err != nil {
+		return err
+	}
+	return repo.Complete(ctx, record, response, string(headers), expiresAt)
+}
+
+// newResponse creates an empty response message for a method from the proto registry
+func newResponse(fullMethod string) (proto.Message, error) {
+	// "/v1.UserService/CreateUser" -> "v1.UserService.CreateUser"
+	name := strings.Replace(strings.TrimPrefix(fullMethod, "/"), "/", ".", 1)
+
+	desc, err := protoregistry.GlobalFiles.FindDescriptorByName(protoreflect.FullName(name))
+	if err != nil {
+		return nil, err
+	}
+	method, ok := desc.(protoreflect.MethodDescriptor)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "%s is not a method", name)
+	}
+	mt, err := protoregistry.GlobalTypes.FindMessageByName(method.Output().FullName())
+	if err != nil {
+		return nil, err
+	}
+	return mt.New().Interface(), nil
+}
+
+func requestFingerprint(msg proto.Message) (string, error) {
+	b, err := proto.MarshalOptions{Deterministic: true}.Marshal(msg)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+func hashParts(parts ...string) string {
+	h := sha256.New()
+	for _, p := range parts {
+		h.Write([]byte(p))
+		h.Write([]byte{0})
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// headerRecorder captures response headers set by the handler while passing them through
+type headerRecorder struct {
+	grpc.ServerTransportStream
+	header metadata.MD
+}
+
+func (r *headerRecorder) SetHeader(md metadata.MD) error {
+	r.header = metadata.Join(r.header, md)
+	return r.ServerTransportStream.SetHeader(md)
+}
+
+func (r *headerRecorder) SendHeader(md metadata.MD) error {
+	r.header = metadata.Join(r.header, md)
+	return r.ServerTransportStream.SendHeader(md)
+}
\ No newline at end of file
@@ -0,0 +1,216 @@
+package interceptor
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	pb "starter-kit-grpc-golang/api/gen/v1"
+	"starter-kit-grpc-golang/internal/models"
+	"starter-kit-grpc-golang/internal/repository"
+	"starter-kit-grpc-golang/internal/testutil"
+
+	"cloud.google.com/go/longrunning/autogen/longrunningpb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+const createUserMethod = "/v1.UserService/CreateUser"
+
+// idempotencyTest drives IdempotencyInterceptor over a real repository, counting handler runs
+type idempotencyTest struct {
+	t           *testing.T
+	repo        repository.IdempotencyRepository
+	interceptor grpc.UnaryServerInterceptor
+	calls       int
+	handlerErr  error
+}
+
+func newIdempotencyTest(t *testing.T) *idempotencyTest {
+	repo := repository.NewIdempotencyRepository(testutil.NewDB(t))
+	return &idempotencyTest{t: t, repo: repo, interceptor: IdempotencyInterceptor(repo, time.Hour)}
+}
+
+func (it *idempotencyTest) call(key string, req *pb.CreateUserRequest) (*pb.UserResponse, error) {
+	it.t.Helper()
+	ctx := context.WithValue(context.Background(), UserIDKey, "user-1")
+	ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(IdempotencyKeyHeader, key))
+
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		it.calls++
+		if it.handlerErr != nil {
+			return nil, it.handlerErr
+		}
+		return &pb.UserResponse{Id: "created-" + req.(*pb.CreateUserRequest).Email}, nil
+	}
+	resp, err := it.interceptor(ctx, req, &grpc.UnaryServerInfo{FullMethod: createUserMethod}, handler)
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*pb.UserResponse), nil
+}
+
+// reserve stores a record for key as if another request held it
+func (it *idempotencyTest) reserve(key string, req *pb.CreateUserRequest, status string, expiresAt time.Time) {
+	it.t.Helper()
+	fingerprint, err := requestFingerprint(req)
+	if err != nil {
+		it.t.Fatal(err)
+	}
+	record := &models.IdempotencyKey{
+		ID:          hashParts("user-1", createUserMethod, key),
+		Method:      createUserMethod,
+		Fingerprint: fingerprint,
+		Status:      status,
+		ExpiresAt:   expiresAt,
+	}
+	if existing, err := it.repo.Reserve(context.Background(), record); err != nil || existing != nil {
+		it.t.Fatalf("reserve: existing %v, error %v", existing, err)
+	}
+}
+
+func TestIdempotencyReplaysResponse(t *testing.T) {
+	it := newIdempotencyTest(t)
+	req := &pb.CreateUserRequest{Name: "A", Email: "a@example.com", Password: "password1"}
+
+	first, err := it.call("key-1", req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	second, err := it.call("key-1", proto.Clone(req).(*pb.CreateUserRequest))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if it.calls != 1 {
+		t.Errorf("handler ran %d times, want 1", it.calls)
+	}
+	if !proto.Equal(first, second) {
+		t.Errorf("replayed %v, want %v", second, first)
+	}
+
+	// Another key is another request
+	if _, err := it.call("key-2", req); err != nil {
+		t.Fatal(err)
+	}
+	if it.calls != 2 {
+		t.Errorf("handler ran %d times, want 2", it.calls)
+	}
+}
+
+func TestIdempotencyRejectsDifferentRequest(t *testing.T) {
+	it := newIdempotencyTest(t)
+
+	if _, err := it.call("key-1", &pb.CreateUserRequest{Email: "a@example.com"}); err != nil {
+		t.Fatal(err)
+	}
+	_, err := it.call("key-1", &pb.CreateUserRequest{Email: "b@example.com"})
+	if code := status.Code(err); code != codes.FailedPrecondition {
+		t.Errorf("reused key for another request: code %v, want FailedPrecondition", code)
+	}
+	if it.calls != 1 {
+		t.Errorf("handler ran %d times, want 1", it.calls)
+	}
+}
+
+func TestIdempotencyPendingKey(t *testing.T) {
+	it := newIdempotencyTest(t)
+	req := &pb.CreateUserRequest{Email: "a@example.com"}
+
+	// A request still inside its lease blocks retries
+	it.reserve("running", req, models.IdempotencyPending, time.Now().Add(time.Minute))
+	if _, err := it.call("running", req); status.Code(err) != codes.Aborted {
+		t.Errorf("retry of a running request: %v, want Aborted", err)
+	}
+
+	// A request whose lease ran out (e.g. the server died) is taken over
+	it.reserve("died", req, models.IdempotencyPending, time.Now().Add(-time.Second))
+	if _, err := it.call("died", req); err != nil {
+		t.Errorf("retry after the lease expired: %v, want it to run", err)
+	}
+	if it.calls != 1 {
+		t.Errorf("handler ran %d times, want 1", it.calls)
+	}
+}
+
+func TestIdempotencyReleasesKeyOnError(t *testing.T) {
+	it := newIdempotencyTest(t)
+	req := &pb.CreateUserRequest{Email: "a@example.com"}
+
+	it.handlerErr = errors.New("boom")
+	if _, err := it.call("key-1", req); err == nil {
+		t.Fatal("want the handler error")
+	}
+
+	it.handlerErr = nil
+	if _, err := it.call("key-1", req); err != nil {
+		t.Fatalf("retry after a failure: %v", err)
+	}
+	if it.calls != 2 {
+		t.Errorf("handler ran %d times, want 2", it.calls)
+	}
+}
+
+func TestIdempotencyWithoutKey(t *testing.T) {
+	it := newIdempotencyTest(t)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		it.calls++
+		return &pb.UserResponse{}, nil
+	}
+	for i := 0; i < 2; i++ {
+		if _, err := it.interceptor(context.Background(), &pb.CreateUserRequest{}, &grpc.UnaryServerInfo{FullMethod: createUserMethod}, handler); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if it.calls != 2 {
+		t.Errorf("handler ran %d times, want 2", it.calls)
+	}
+}
+func TestIdempotencyOnlyForAllowedMethods(t *testing.T) {
+	it := newIdempotencyTest(t)
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(IdempotencyKeyHeader, "key-1"))
+
+	// Login returns live tokens: a retry must log in again, not replay them
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		it.calls++
+		return &pb.AuthResponse{Tokens: &pb.TokenPair{Refresh: &pb.TokenPair_TokenDetail{Token: "refresh"}}}, nil
+	}
+	info := &grpc.UnaryServerInfo{FullMethod: "/v1.AuthService/Login"}
+	for i := 0; i < 2; i++ {
+		if _, err := it.interceptor(ctx, &pb.LoginRequest{Email: "a@example.com"}, info, handler); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if it.calls != 2 {
+		t.Errorf("handler ran %d times, want 2", it.calls)
+	}
+}
+
+func TestIdempotencyDoesNotStoreSensitiveResponses(t *testing.T) {
+	it := newIdempotencyTest(t)
+	ctx := context.WithValue(context.Background(), UserIDKey, "user-1")
+	ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(IdempotencyKeyHeader, "key-1"))
+
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		it.calls++
+		response, err := anypb.New(&pb.ExportUsersResponse{Content: []byte("name,email\n")})
+		if err != nil {
+			return nil, err
+		}
+		return &longrunningpb.Operation{Name: "operations/1", Done: true, Result: &longrunningpb.Operation_Response{Response: response}}, nil
+	}
+	info := &grpc.UnaryServerInfo{FullMethod: "/v1.UserService/ExportUsers"}
+	for i := 0; i < 2; i++ {
+		if _, err := it.interceptor(ctx, &pb.ExportUsersRequest{Format: "csv"}, info, handler); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if it.calls != 2 {
+		t.Errorf("handler ran %d times, want 2: the response was stored", it.calls)
+	}
+}
\ No newline at end of file
@@ -2,39 +2,151 @@ package interceptor
 
 import (
 	"context"
+	"log/slog"
 	"time"
 
 	"starter-kit-grpc-golang/pkg/logger"
+	"starter-kit-grpc-golang/pkg/redact"
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 )
 
-func LoggerInterceptor() grpc.UnaryServerInterceptor {
+// LoggerInterceptor logs each RPC when it completes. With logPayloads, the request and
+// response messages are also logged at debug level, redacted (see pkg/redact).
+func LoggerInterceptor(logPayloads bool) grpc.UnaryServerInterceptor {
 	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
 		start := time.Now()
 
 		// Call the handler
 		resp, err := handler(ctx, req)
 
+		if logPayloads {
+			log, logArgs := requestLogger(ctx, info.FullMethod)
+			log.Debug("gRPC Payload", append(logArgs, "request", payload(req), "response", payload(resp))...)
+		}
+
 		duration := time.Since(start)
 		code := status.Code(err)
 
 		// Log Request
 		// Level: Info for success, Error for failures
-		logArgs := []interface{}{
-			"method", info.FullMethod,
+		log, logArgs := requestLogger(ctx, info.FullMethod)
+		logArgs = append(logArgs,
 			"code", code.String(),
 			"duration", duration.String(),
-		}
+		)
 
 		if err != nil {
 			logArgs = append(logArgs, "error", err.Error())
-			logger.Log.Error("gRPC Request Failed", logArgs...)
+			log.Error("gRPC Request Failed", logArgs...)
 		} else {
-			logger.Log.Info("gRPC Request Processed", logArgs...)
+			log.Info("gRPC Request Processed", logArgs...)
 		}
 
 		return resp, err
 	}
+}
+
+// requestLogger returns the request-scoped logger set up by RequestIDInterceptor, and the
+// attributes it still lacks: the method if there is no request logger, and the user ID
+// once AuthInterceptor has identified the caller
+func requestLogger(ctx context.Context, method string) (*slog.Logger, []interface{}) {
+	var args []interface{}
+	if RequestIDFromContext(ctx) == "" {
+		args = append(args, "method", method)
+	}
+	if c, ok := ctx.Value(callerKey).(*caller); ok && c.userID != "" {
+		args = append(args, "user_id", c.userID)
+	}
+	return logger.FromContext(ctx), args
+}
+
+// MessageDirection says which way a stream message travelled
+type MessageDirection string
+
+const (
+	MessageReceived MessageDirection = "received" // Client -> server
+	MessageSent     MessageDirection = "sent"     // Server -> client
+)
+
+// MessageHook observes every message on a stream, e.g. to count or sample it
+type MessageHook func(ctx context.Context, method string, direction MessageDirection, msg interface{})
+
+// StreamLoggerInterceptor logs each stream when it closes, with the number of messages
+// exchanged. Individual messages are logged at debug level (with their redacted
+// content if logPayloads is set) and passed to hooks.
+func StreamLoggerInterceptor(logPayloads bool, hooks ...MessageHook) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		start := time.Now()
+		stream := &loggingStream{ServerStream: ss, method: info.FullMethod, logPayloads: logPayloads, hooks: hooks}
+
+		// Call the handler
+		err := handler(srv, stream)
+
+		log, logArgs := requestLogger(ss.Context(), info.FullMethod)
+		logArgs = append(logArgs,
+			"code", status.Code(err).String(),
+			"duration", time.Since(start).String(),
+			"received", stream.received,
+			"sent", stream.sent,
+		)
+
+		if err != nil {
+			logArgs = append(logArgs, "error", err.Error())
+			log.Error("gRPC Stream Failed", logArgs...)
+		} else {
+			log.Info("gRPC Stream Closed", logArgs...)
+		}
+
+		return err
+	}
+}
+
+type loggingStream struct {
+	grpc.ServerStream
+	method      string
+	logPayloads bool
+	hooks       []MessageHook
+	received    int
+	sent        int
+}
+
+func (s *loggingStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	s.received++
+	s.observe(MessageReceived, m)
+	return nil
+}
+
+func (s *loggingStream) SendMsg(m interface{}) error {
+	if err := s.ServerStream.SendMsg(m); err != nil {
+		return err
+	}
+	s.sent++
+	s.observe(MessageSent, m)
+	return nil
+}
+
+func (s *loggingStream) observe(direction MessageDirection, m interface{}) {
+	log, logArgs := requestLogger(s.Context(), s.method)
+	logArgs = append(logArgs, "direction", string(direction))
+	if s.logPayloads {
+		logArgs = append(logArgs, "message", payload(m))
+	}
+	log.Debug("gRPC Stream Message", logArgs...)
+	for _, hook := range s.hooks {
+		hook(s.Context(), s.method, direction, m)
+	}
+}
+
+// payload is the loggable form of a message: only protos are logged, and only redacted
+func payload(m interface{}) interface{} {
+	if msg, ok := m.(proto.Message); ok {
+		return redact.Proto(msg)
+	}
+	return nil
 }
\ No newline at end of file
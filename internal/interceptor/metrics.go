@@ -0,0 +1,43 @@
+package interceptor
+
+import (
+	"context"
+	"time"
+
+	"starter-kit-grpc-golang/internal/metrics"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+// MetricsInterceptor records the count and latency of each RPC by method and code.
+// It should run first in the chain, so panics turned into errors by recovery are counted too.
+func MetricsInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		start := time.Now()
+		resp, err := handler(ctx, req)
+		observeRPC(info.FullMethod, start, err)
+		return resp, err
+	}
+}
+
+// StreamMetricsInterceptor is MetricsInterceptor for streams. Pass MetricsMessageHook to
+// StreamLoggerInterceptor to count individual messages as well.
+func StreamMetricsInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		start := time.Now()
+		err := handler(srv, ss)
+		observeRPC(info.FullMethod, start, err)
+		return err
+	}
+}
+
+// MetricsMessageHook counts stream messages by method and direction
+func MetricsMessageHook(ctx context.Context, method string, direction MessageDirection, msg interface{}) {
+	metrics.RPCStreamMessages.WithLabelValues(method, string(direction)).Inc()
+}
+
+func observeRPC(method string, start time.Time, err error) {
+	metrics.RPCHandled.WithLabelValues(method, status.Code(err).String()).Inc()
+	metrics.RPCDuration.WithLabelValues(method).Observe(time.Since(start).Seconds())
+}
\ No newline at end of file
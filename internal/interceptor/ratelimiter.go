@@ -2,17 +2,22 @@ package interceptor
 
 import (
 	"context"
-	"net"
 	"sync"
+	"time"
+
+	"starter-kit-grpc-golang/internal/requestctx"
 
 	"golang.org/x/time/rate"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/metadata"
-	"google.golang.org/grpc/peer"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
 )
 
+// ErrorDomain is the google.rpc.ErrorInfo domain of errors raised by this API
+const ErrorDomain = "starter-kit-grpc-golang"
+
 // IPRateLimiter holds rate limiters for each IP
 type IPRateLimiter struct {
 	ips map[string]*rate.Limiter
@@ -43,41 +48,71 @@ func (i *IPRateLimiter) GetLimiter(ip string) *rate.Limiter {
 	return limiter
 }
 
-// RateLimitInterceptor creates the interceptor
-func RateLimitInterceptor() grpc.UnaryServerInterceptor {
-	// Allow 5 requests per second with burst of 20
-	limiter := NewIPRateLimiter(5, 20)
+// Allow checks out one token for ip, or returns a ResourceExhausted error saying when to retry
+func (i *IPRateLimiter) Allow(ip string) error {
+	reservation := i.GetLimiter(ip).Reserve()
+	if delay := reservation.Delay(); delay > 0 {
+		reservation.Cancel()
+		return rateLimitedError(delay)
+	}
+	return nil
+}
 
+// RateLimitInterceptor creates the interceptor. Share limiter with StreamRateLimitInterceptor
+// so unary and streaming calls draw from the same budget.
+func RateLimitInterceptor(limiter *IPRateLimiter) grpc.UnaryServerInterceptor {
 	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
-		clientIP := getClientIP(ctx)
-		
-		if !limiter.GetLimiter(clientIP).Allow() {
-			return nil, status.Error(codes.ResourceExhausted, "too many requests")
+		if err := limiter.Allow(requestctx.ClientIP(ctx)); err != nil {
+			return nil, err
 		}
 
 		return handler(ctx, req)
 	}
 }
 
-// getClientIP attempts to resolve IP from Metadata (Gateway) or Peer (Direct gRPC)
-func getClientIP(ctx context.Context) string {
-	// 1. Try X-Forwarded-For (From HTTP Gateway)
-	md, ok := metadata.FromIncomingContext(ctx)
-	if ok {
-		vals := md.Get("x-forwarded-for")
-		if len(vals) > 0 {
-			return vals[0]
+// StreamRateLimitInterceptor charges one token to open a stream and one for every
+// further message the client sends on it
+func StreamRateLimitInterceptor(limiter *IPRateLimiter) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		clientIP := requestctx.ClientIP(ss.Context())
+		if err := limiter.Allow(clientIP); err != nil {
+			return err
 		}
+
+		return handler(srv, &rateLimitedStream{ServerStream: ss, limiter: limiter, clientIP: clientIP})
 	}
+}
 
-	// 2. Try Peer Info
-	if p, ok := peer.FromContext(ctx); ok {
-		host, _, err := net.SplitHostPort(p.Addr.String())
-		if err == nil {
-			return host
+type rateLimitedStream struct {
+	grpc.ServerStream
+	limiter  *IPRateLimiter
+	clientIP string
+	received int
+}
+
+func (s *rateLimitedStream) RecvMsg(m interface{}) error {
+	// The first message is covered by the token spent opening the stream
+	if s.received > 0 {
+		if err := s.limiter.Allow(s.clientIP); err != nil {
+			return err
 		}
-		return p.Addr.String()
 	}
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	s.received++
+	return nil
+}
 
-	return "unknown"
+// rateLimitedError tells the client when its next request will be allowed
+func rateLimitedError(delay time.Duration) error {
+	st := status.New(codes.ResourceExhausted, "too many requests")
+	detailed, err := st.WithDetails(
+		&errdetails.ErrorInfo{Reason: "RATE_LIMITED", Domain: ErrorDomain},
+		&errdetails.RetryInfo{RetryDelay: durationpb.New(delay)},
+	)
+	if err != nil {
+		return st.Err()
+	}
+	return detailed.Err()
 }
\ No newline at end of file
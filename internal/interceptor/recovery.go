@@ -2,25 +2,95 @@ package interceptor
 
 import (
 	"context"
+	"fmt"
 	"runtime/debug"
+	"time"
 
+	"starter-kit-grpc-golang/internal/crash"
 	"starter-kit-grpc-golang/pkg/logger"
 
+	"github.com/google/uuid"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 )
 
-func RecoveryInterceptor() grpc.UnaryServerInterceptor {
-	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+// RecoveryInterceptor turns a panic in a handler into an Internal error carrying an
+// incident ID, and files a crash report under that ID. It should run early in the
+// chain so it also covers the interceptors after it.
+func RecoveryInterceptor(reporter crash.Reporter, panics *crash.Counter) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
+		ctx, c := withCaller(ctx)
+
 		defer func() {
 			if r := recover(); r != nil {
-				logger.Log.Error("Panic recovered", 
-					"error", r, 
-					"stack", string(debug.Stack()),
-					"method", info.FullMethod,
-				)
+				report := newCrashReport(info.FullMethod, r, c)
+				if msg, ok := req.(proto.Message); ok {
+					report.Request = crash.SanitizeRequest(msg)
+				}
+				resp, err = nil, recovered(ctx, reporter, panics, report)
 			}
 		}()
 
 		return handler(ctx, req)
 	}
+}
+
+// StreamRecoveryInterceptor is RecoveryInterceptor for streams; the stream is closed
+// with the Internal error instead of crashing the server
+func StreamRecoveryInterceptor(reporter crash.Reporter, panics *crash.Counter) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) (err error) {
+		ctx, c := withCaller(ss.Context())
+
+		defer func() {
+			if r := recover(); r != nil {
+				err = recovered(ctx, reporter, panics, newCrashReport(info.FullMethod, r, c))
+			}
+		}()
+
+		return handler(srv, &wrappedServerStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+func newCrashReport(method string, r interface{}, c *caller) *crash.Report {
+	return &crash.Report{
+		IncidentID: uuid.NewString(),
+		Time:       time.Now().UTC(),
+		Method:     method,
+		Panic:      fmt.Sprint(r),
+		Stack:      string(debug.Stack()),
+		UserID:     c.userID,
+		Role:       c.role,
+	}
+}
+
+// recovered logs and files the report, then returns the error the client sees
+func recovered(ctx context.Context, reporter crash.Reporter, panics *crash.Counter, report *crash.Report) error {
+	report.Count = panics.Inc(report.Method)
+	report.RequestID = RequestIDFromContext(ctx)
+
+	log := logger.FromContext(ctx)
+	log.Error("Panic recovered",
+		"error", report.Panic,
+		"stack", report.Stack,
+		"method", report.Method,
+		"incident_id", report.IncidentID,
+		"count", report.Count,
+	)
+	if err := reporter.Report(report); err != nil {
+		log.Error("Failed to file crash report", "incident_id", report.IncidentID, "error", err)
+	}
+
+	st := status.New(codes.Internal, "internal error (incident "+report.IncidentID+")")
+	detailed, err := st.WithDetails(&errdetails.ErrorInfo{
+		Reason:   "INTERNAL",
+		Domain:   ErrorDomain,
+		Metadata: map[string]string{"incident_id": report.IncidentID},
+	})
+	if err != nil {
+		return st.Err()
+	}
+	return detailed.Err()
 }
\ No newline at end of file
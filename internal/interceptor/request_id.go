@@ -0,0 +1,86 @@
+package interceptor
+
+import (
+	"context"
+
+	"starter-kit-grpc-golang/pkg/logger"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	RequestIDHeader    = "x-request-id"
+	maxRequestIDLength = 128
+)
+
+// RequestIDInterceptor accepts the caller's x-request-id (or generates one), echoes it in
+// the response headers, and puts a request-scoped logger into the context carrying the
+// request ID, method and trace ID. AuthInterceptor adds the user ID once it is known.
+// Must run before the interceptors that log.
+func RequestIDInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		ctx = withRequestID(ctx, info.FullMethod)
+		grpc.SetHeader(ctx, metadata.Pairs(RequestIDHeader, RequestIDFromContext(ctx)))
+		return handler(ctx, req)
+	}
+}
+
+// StreamRequestIDInterceptor is RequestIDInterceptor for streams
+func StreamRequestIDInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx := withRequestID(ss.Context(), info.FullMethod)
+		ss.SetHeader(metadata.Pairs(RequestIDHeader, RequestIDFromContext(ctx)))
+		return handler(srv, &wrappedServerStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+type requestIDKey struct{}
+
+// RequestIDFromContext returns the ID of the request being served, or ""
+func RequestIDFromContext(ctx context.Context) string {
+	id, _ := ctx.Value(requestIDKey{}).(string)
+	return id
+}
+
+func withRequestID(ctx context.Context, method string) context.Context {
+	id := incomingRequestID(ctx)
+	if id == "" {
+		id = uuid.NewString()
+	}
+	ctx = context.WithValue(ctx, requestIDKey{}, id)
+
+	// Lets interceptors that run before authentication log the user too
+	ctx, _ = withCaller(ctx)
+
+	args := []interface{}{"request_id", id, "method", method}
+	if sc := trace.SpanContextFromContext(ctx); sc.HasTraceID() {
+		args = append(args, "trace_id", sc.TraceID().String())
+	}
+	return logger.With(ctx, args...)
+}
+
+// incomingRequestID returns the caller's ID if it is valid
+func incomingRequestID(ctx context.Context) string {
+	md, _ := metadata.FromIncomingContext(ctx)
+	if ids := md.Get(RequestIDHeader); len(ids) > 0 && ValidRequestID(ids[0]) {
+		return ids[0]
+	}
+	return ""
+}
+
+// ValidRequestID reports whether a client-supplied ID is safe to log and echo:
+// 1-128 printable ASCII characters without spaces
+func ValidRequestID(id string) bool {
+	if id == "" || len(id) > maxRequestIDLength {
+		return false
+	}
+	for _, r := range id {
+		if r < 0x21 || r > 0x7e {
+			return false
+		}
+	}
+	return true
+}
\ No newline at end of file
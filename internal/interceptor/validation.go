@@ -0,0 +1,73 @@
+package interceptor
+
+import (
+	"context"
+	"strings"
+
+	"starter-kit-grpc-golang/pkg/validator"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// ValidationInterceptor rejects requests that break the (v1.rules) constraints declared
+// in the protos, before the handler runs
+func ValidationInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if msg, ok := req.(proto.Message); ok {
+			if violations := validator.Validate(msg); len(violations) > 0 {
+				return nil, validationError(violations)
+			}
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamValidationInterceptor validates every message a client sends on a stream
+func StreamValidationInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return handler(srv, &validatingStream{ServerStream: ss})
+	}
+}
+
+type validatingStream struct {
+	grpc.ServerStream
+}
+
+func (s *validatingStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	if msg, ok := m.(proto.Message); ok {
+		if violations := validator.Validate(msg); len(violations) > 0 {
+			return validationError(violations)
+		}
+	}
+	return nil
+}
+
+// validationError is an InvalidArgument status listing every violation as a BadRequest field violation
+func validationError(violations []validator.Violation) error {
+	messages := make([]string, len(violations))
+	badRequest := &errdetails.BadRequest{}
+	for i, v := range violations {
+		messages[i] = v.String()
+		badRequest.FieldViolations = append(badRequest.FieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       v.Field,
+			Description: v.Description,
+		})
+	}
+
+	st := status.New(codes.InvalidArgument, "invalid request: "+strings.Join(messages, "; "))
+	detailed, err := st.WithDetails(
+		&errdetails.ErrorInfo{Reason: "INVALID_REQUEST", Domain: ErrorDomain},
+		badRequest,
+	)
+	if err != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
\ No newline at end of file
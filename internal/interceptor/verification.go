@@ -0,0 +1,120 @@
+package interceptor
+
+import (
+	"context"
+	"strings"
+	"sync"
+
+	pb "starter-kit-grpc-golang/api/gen/v1"
+	"starter-kit-grpc-golang/config"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+// EmailVerificationInterceptor rejects callers without a verified email on methods that require one.
+// Must run after AuthInterceptor, which puts the verification claim into the context.
+func EmailVerificationInterceptor(cfg *config.Config) grpc.UnaryServerInterceptor {
+	requiresVerification := verificationRequirements(cfg)
+
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if !requiresVerification(info.FullMethod) {
+			return handler(ctx, req)
+		}
+
+		if verified, _ := ctx.Value(EmailVerifiedKey).(bool); !verified {
+			return nil, emailNotVerifiedError(ctx)
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// StreamEmailVerificationInterceptor is EmailVerificationInterceptor for streams.
+// Must run after StreamAuthInterceptor.
+func StreamEmailVerificationInterceptor(cfg *config.Config) grpc.StreamServerInterceptor {
+	requiresVerification := verificationRequirements(cfg)
+
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if !requiresVerification(info.FullMethod) {
+			return handler(srv, ss)
+		}
+
+		if verified, _ := ss.Context().Value(EmailVerifiedKey).(bool); !verified {
+			return emailNotVerifiedError(ss.Context())
+		}
+
+		return handler(srv, ss)
+	}
+}
+
+// verificationRequirements reports whether a method requires a verified email, either
+// through EMAIL_VERIFICATION_REQUIRED_METHODS or its proto option
+func verificationRequirements(cfg *config.Config) func(fullMethod string) bool {
+	configured := make(map[string]bool, len(cfg.VerifiedEmailMethods))
+	for _, m := range cfg.VerifiedEmailMethods {
+		configured[m] = true
+	}
+
+	// Proto option lookups are cached per method
+	var cache sync.Map
+
+	requiresVerification := func(fullMethod string) bool {
+		if configured[fullMethod] {
+			return true
+		}
+		if v, ok := cache.Load(fullMethod); ok {
+			return v.(bool)
+		}
+		required := methodRequiresVerifiedEmail(fullMethod)
+		cache.Store(fullMethod, required)
+		return required
+	}
+	return requiresVerification
+}
+
+// methodRequiresVerifiedEmail reads the (v1.requires_verified_email) option from the method descriptor
+func methodRequiresVerifiedEmail(fullMethod string) bool {
+	// "/v1.AuthService/InviteUser" -> "v1.AuthService.InviteUser"
+	name := strings.Replace(strings.TrimPrefix(fullMethod, "/"), "/", ".", 1)
+
+	desc, err := protoregistry.GlobalFiles.FindDescriptorByName(protoreflect.FullName(name))
+	if err != nil {
+		return false
+	}
+	method, ok := desc.(protoreflect.MethodDescriptor)
+	if !ok || method.Options() == nil {
+		return false
+	}
+
+	required, _ := proto.GetExtension(method.Options(), pb.E_RequiresVerifiedEmail).(bool)
+	return required
+}
+
+func emailNotVerifiedError(ctx context.Context) error {
+	userID, _ := ctx.Value(UserIDKey).(string)
+
+	st := status.New(codes.FailedPrecondition, "email address is not verified")
+	detailed, err := st.WithDetails(
+		&errdetails.ErrorInfo{
+			Reason: "EMAIL_NOT_VERIFIED",
+			Domain: "auth",
+		},
+		&errdetails.PreconditionFailure{
+			Violations: []*errdetails.PreconditionFailure_Violation{{
+				Type:        "EMAIL_VERIFICATION",
+				Subject:     "users/" + userID,
+				Description: "verify your email address via SendVerificationEmail, then refresh your tokens, before calling this method",
+			}},
+		},
+	)
+	if err != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
\ No newline at end of file
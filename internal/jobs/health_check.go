@@ -0,0 +1,26 @@
+package jobs
+
+import (
+	"context"
+	"time"
+
+	"starter-kit-grpc-golang/internal/health"
+)
+
+// StartHealthChecks re-runs the dependency checks every interval. It runs until ctx is cancelled.
+func StartHealthChecks(ctx context.Context, monitor *health.Monitor, interval time.Duration) {
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+
+			monitor.Run(ctx)
+		}
+	}()
+}
\ No newline at end of file
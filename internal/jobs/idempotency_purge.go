@@ -0,0 +1,39 @@
+package jobs
+
+import (
+	"context"
+	"time"
+
+	"starter-kit-grpc-golang/internal/repository"
+	"starter-kit-grpc-golang/pkg/logger"
+)
+
+// StartIdempotencyPurgeJob deletes expired idempotency keys. It runs until ctx is cancelled.
+func StartIdempotencyPurgeJob(ctx context.Context, repo repository.IdempotencyRepository, ttl time.Duration) {
+	interval := time.Hour
+	if ttl > 0 && ttl < interval {
+		interval = ttl
+	}
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+
+			purged, err := repo.PurgeExpired(ctx, time.Now())
+			if err != nil {
+				logger.Log.Error("Failed to purge idempotency keys", "error", err)
+				continue
+			}
+			if purged > 0 {
+				logger.Log.Info("Purged expired idempotency keys", "count", purged)
+			}
+		}
+	}()
+}
\ No newline at end of file
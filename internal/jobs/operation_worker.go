@@ -0,0 +1,42 @@
+package jobs
+
+import (
+	"context"
+	"time"
+
+	"starter-kit-grpc-golang/internal/service"
+	"starter-kit-grpc-golang/pkg/logger"
+)
+
+// StartOperationWorkers runs the long-running operation workers and forgets finished
+// operations after retention. It runs until ctx is cancelled.
+func StartOperationWorkers(ctx context.Context, operationService service.OperationService, workers int, retention time.Duration) {
+	if workers < 1 {
+		workers = 1
+	}
+	for i := 0; i < workers; i++ {
+		go operationService.Work(ctx)
+	}
+
+	interval := time.Hour
+	if retention > 0 && retention < interval {
+		interval = retention
+	}
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+
+			if pruned := operationService.PruneDone(time.Now().Add(-retention)); pruned > 0 {
+				logger.Log.Info("Pruned finished operations", "count", pruned, "retention", retention.String())
+			}
+		}
+	}()
+}
\ No newline at end of file
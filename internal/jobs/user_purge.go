@@ -0,0 +1,44 @@
+package jobs
+
+import (
+	"context"
+	"time"
+
+	"starter-kit-grpc-golang/internal/service"
+	"starter-kit-grpc-golang/pkg/logger"
+)
+
+// StartUserPurgeJob periodically hard-deletes users soft-deleted longer than retention.
+// It runs until ctx is cancelled.
+func StartUserPurgeJob(ctx context.Context, userService service.UserService, interval, retention time.Duration) {
+	if interval <= 0 {
+		logger.Log.Warn("User purge job disabled", "interval", interval.String())
+		return
+	}
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			purgeDeletedUsers(ctx, userService, retention)
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+func purgeDeletedUsers(ctx context.Context, userService service.UserService, retention time.Duration) {
+	purged, err := userService.PurgeDeletedUsers(ctx, retention)
+	if err != nil {
+		logger.Log.Error("Failed to purge deleted users", "error", err)
+		return
+	}
+	if purged > 0 {
+		logger.Log.Info("Purged deleted users", "count", purged, "retention", retention.String())
+	}
+}
\ No newline at end of file
@@ -0,0 +1,32 @@
+package metrics
+
+import (
+	"starter-kit-grpc-golang/internal/crash"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var panicsDesc = prometheus.NewDesc(
+	"grpc_server_panics_total",
+	"Panics recovered from RPC handlers, by method.",
+	[]string{"method"}, nil,
+)
+
+type panicCollector struct {
+	counter *crash.Counter
+}
+
+// RegisterPanics exports the recovery interceptor's per-method panic counts
+func RegisterPanics(counter *crash.Counter) error {
+	return Registry.Register(&panicCollector{counter: counter})
+}
+
+func (c *panicCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- panicsDesc
+}
+
+func (c *panicCollector) Collect(ch chan<- prometheus.Metric) {
+	for method, n := range c.counter.Snapshot() {
+		ch <- prometheus.MustNewConstMetric(panicsDesc, prometheus.CounterValue, float64(n), method)
+	}
+}
\ No newline at end of file
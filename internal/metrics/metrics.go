@@ -0,0 +1,97 @@
+// Package metrics defines the Prometheus metrics exported on the metrics port
+package metrics
+
+import (
+	"net/http"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// Registry holds every metric below, plus Go runtime and process metrics
+var Registry = prometheus.NewRegistry()
+
+var factory = promauto.With(Registry)
+
+func init() {
+	Registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+	)
+}
+
+// --- gRPC ---
+
+var (
+	RPCHandled = factory.NewCounterVec(prometheus.CounterOpts{
+		Name: "grpc_server_handled_total",
+		Help: "RPCs completed on the server, by method and status code.",
+	}, []string{"method", "code"})
+
+	RPCDuration = factory.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "grpc_server_handling_seconds",
+		Help:    "Time to complete an RPC (for streams, until the stream closes).",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"method"})
+
+	RPCStreamMessages = factory.NewCounterVec(prometheus.CounterOpts{
+		Name: "grpc_server_stream_messages_total",
+		Help: "Messages exchanged on streams, by method and direction (received or sent).",
+	}, []string{"method", "direction"})
+)
+
+// --- HTTP Gateway ---
+
+var (
+	HTTPRequests = factory.NewCounterVec(prometheus.CounterOpts{
+		Name: "http_requests_total",
+		Help: "Gateway HTTP requests, by HTTP method, route pattern and status code.",
+	}, []string{"method", "route", "code"})
+
+	HTTPDuration = factory.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "http_request_duration_seconds",
+		Help:    "Gateway HTTP request latency, by HTTP method and route pattern.",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"method", "route"})
+)
+
+// --- Database ---
+
+var (
+	DBQueryDuration = factory.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "db_query_duration_seconds",
+		Help:    "GORM statement latency, by operation and table.",
+		Buckets: []float64{.0005, .001, .0025, .005, .01, .025, .05, .1, .25, .5, 1},
+	}, []string{"operation", "table"})
+
+	DBQueryErrors = factory.NewCounterVec(prometheus.CounterOpts{
+		Name: "db_query_errors_total",
+		Help: "GORM statements that failed (not counting record not found), by operation and table.",
+	}, []string{"operation", "table"})
+)
+
+// --- Domain ---
+
+var (
+	Registrations = factory.NewCounterVec(prometheus.CounterOpts{
+		Name: "app_registrations_total",
+		Help: "Accounts created, by source (signup or invite).",
+	}, []string{"source"})
+
+	Logins = factory.NewCounterVec(prometheus.CounterOpts{
+		Name: "app_logins_total",
+		Help: "Login attempts, by result (success, invalid_credentials, or the blocking account status).",
+	}, []string{"result"})
+
+	EmailsSent = factory.NewCounterVec(prometheus.CounterOpts{
+		Name: "app_emails_total",
+		Help: "Outgoing emails, by template and result (sent, failed, or skipped when SMTP is not configured).",
+	}, []string{"template", "result"})
+)
+
+// Handler serves the registry in the Prometheus exposition format
+func Handler() http.Handler {
+	return promhttp.HandlerFor(Registry, promhttp.HandlerOpts{Registry: Registry})
+}
\ No newline at end of file
@@ -0,0 +1,84 @@
+package models
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"strconv"
+	"time"
+)
+
+const (
+	AuditOutcomeSuccess = "success"
+	AuditOutcomeFailure = "failure"
+)
+
+const (
+	AuditLoginSuccess         = "auth.login.success"
+	AuditLoginFailure         = "auth.login.failure"
+	AuditLogout               = "auth.logout"
+	AuditTokenRefresh         = "auth.token.refresh"
+	AuditRegister             = "auth.register"
+	AuditPasswordResetRequest = "auth.password.reset_requested"
+	AuditPasswordReset        = "auth.password.reset"
+	AuditEmailVerified        = "auth.email.verified"
+	AuditInviteSent           = "auth.invite.sent"
+	AuditInviteAccepted       = "auth.invite.accepted"
+	AuditUserCreated          = "user.created"
+	AuditUserUpdated          = "user.updated"
+	AuditUserDeleted          = "user.deleted"
+	AuditUserRestored         = "user.restored"
+	AuditUserStatusChanged    = "user.status_changed"
+)
+
+// AuditEvent is an append-only, hash-chained record of a security-relevant action
+type AuditEvent struct {
+	ID        uint      `gorm:"primary_key"`
+	EventType string    `gorm:"index;not null"`
+	ActorID   string    `gorm:"index"` // Empty for anonymous callers (e.g. failed login)
+	TargetID  string    `gorm:"index"`
+	IP        string    `gorm:"type:varchar(64)"`
+	UserAgent string    `gorm:"type:text"`
+	Outcome   string    `gorm:"not null"`
+	Details   string    `gorm:"type:text"` // Free-form context, never secrets
+	CreatedAt time.Time `gorm:"index;not null"`
+	PrevHash  string    `gorm:"type:char(64);uniqueIndex"` // Unique: concurrent writers can't fork the chain
+	Hash      string    `gorm:"type:char(64);uniqueIndex;not null"`
+}
+
+// AuditChainHead records the latest event of the chain. It is updated with every
+// append, so removing events from the end of the chain is detected too.
+type AuditChainHead struct {
+	ID        uint   `gorm:"primary_key"` // Always 1: there is a single chain
+	EventID   uint   `gorm:"not null"`
+	Hash      string `gorm:"type:char(64);not null"`
+	UpdatedAt time.Time
+}
+
+// ComputeHash derives the chain hash from the previous hash, the sequence ID and every
+// content field, so editing any column, renumbering or removing a row breaks verification.
+// It is an HMAC keyed with a secret kept outside the database: with write access to the
+// database alone, an edited row can't be rehashed along with the rest of the chain.
+// Each field is length-prefixed, so text can't be moved from one field to the next.
+func (e *AuditEvent) ComputeHash(key []byte) string {
+	mac := hmac.New(sha256.New, key)
+	for _, field := range []string{
+		e.PrevHash,
+		strconv.FormatUint(uint64(e.ID), 10),
+		e.EventType,
+		e.ActorID,
+		e.TargetID,
+		e.IP,
+		e.UserAgent,
+		e.Outcome,
+		e.Details,
+		e.CreatedAt.UTC().Format(time.RFC3339Nano),
+	} {
+		var length [8]byte
+		binary.BigEndian.PutUint64(length[:], uint64(len(field)))
+		mac.Write(length[:])
+		mac.Write([]byte(field))
+	}
+	return hex.EncodeToString(mac.Sum(nil))
+}
\ No newline at end of file
@@ -0,0 +1,24 @@
+package models
+
+import (
+	"time"
+)
+
+const (
+	IdempotencyPending   = "pending"
+	IdempotencyCompleted = "completed"
+)
+
+// IdempotencyKey remembers the outcome of a request sent with an Idempotency-Key,
+// so a retry within the TTL replays it instead of running the RPC again
+type IdempotencyKey struct {
+	ID          string    `gorm:"primary_key"` // Hash of caller, method and client key
+	Method      string    `gorm:"not null"`
+	Fingerprint string    `gorm:"not null"` // Hash of the request message
+	Status      string    `gorm:"not null"`
+	LeaseID     string    // Random per reservation; only its holder may complete or release it
+	Response    []byte    // Marshalled response message, once completed
+	Headers     string    `gorm:"type:text"` // JSON response metadata (e.g. x-http-code)
+	CreatedAt   time.Time `gorm:"autoCreateTime"`
+	ExpiresAt   time.Time `gorm:"index;not null"` // A short lease while pending, the TTL once completed
+}
\ No newline at end of file
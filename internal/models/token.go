@@ -8,6 +8,7 @@ const (
 	TokenTypeRefresh       = "refresh"
 	TokenTypeResetPassword = "resetPassword"
 	TokenTypeVerifyEmail   = "verifyEmail"
+	TokenTypeInvite        = "invite"
 )
 
 type Token struct {
@@ -1,6 +1,7 @@
 package models
 
 import (
+	"strconv"
 	"time"
 
 	"starter-kit-grpc-golang/pkg/utils"
@@ -9,15 +10,31 @@ import (
 	"gorm.io/gorm"
 )
 
+const (
+	RoleUser  = "user"
+	RoleAdmin = "admin"
+)
+
+const (
+	UserStatusActive      = "active"
+	UserStatusSuspended   = "suspended"
+	UserStatusDeactivated = "deactivated"
+)
+
 type User struct {
-	ID              string    `gorm:"type:uuid;primary_key;"` // Stored as string to match Proto
-	Name            string    `gorm:"not null"`
-	Email           string    `gorm:"uniqueIndex;not null"`
-	Password        string    `gorm:"not null"`
-	Role            string    `gorm:"default:'user'"`
-	IsEmailVerified bool      `gorm:"default:false"`
-	CreatedAt       time.Time `gorm:"autoCreateTime"`
-	UpdatedAt       time.Time `gorm:"autoUpdateTime"`
+	ID              string         `gorm:"type:uuid;primary_key;"` // Stored as string to match Proto
+	Name            string         `gorm:"not null"`
+	Email           string         `gorm:"uniqueIndex;not null"`
+	Password        string         `gorm:"not null"`
+	Role            string         `gorm:"default:'user'"`
+	IsEmailVerified bool           `gorm:"default:false"`
+	Status          string         `gorm:"default:'active';index"`
+	StatusReason    string         `gorm:"type:text"`
+	StatusChangedAt *time.Time     `gorm:"default:null"`
+	CreatedAt       time.Time      `gorm:"autoCreateTime"`
+	UpdatedAt       time.Time      `gorm:"autoUpdateTime"`
+	Version         int64          `gorm:"not null;default:1"` // Bumped on every update, exposed as the etag
+	DeletedAt       gorm.DeletedAt `gorm:"index"`              // Soft delete, purged after retention
 }
 
 // BeforeCreate generates a UUID if one doesn't exist
@@ -25,13 +42,24 @@ func (u *User) BeforeCreate(tx *gorm.DB) (err error) {
 	if u.ID == "" {
 		u.ID = uuid.New().String()
 	}
+	if u.Version == 0 {
+		u.Version = 1
+	}
 	return
 }
 
-// BeforeSave hashes the password if it's not already hashed (simple length check)
+// ETag is the strong entity tag of the current version ("" if the version is unknown)
+func (u *User) ETag() string {
+	if u.Version == 0 {
+		return ""
+	}
+	return strconv.Quote(strconv.FormatInt(u.Version, 10))
+}
+
+// BeforeSave hashes the password if it's not already hashed.
 // In a real production app, checking Changed() is more robust, but this matches the starter kit.
 func (u *User) BeforeSave(tx *gorm.DB) (err error) {
-	if u.Password != "" && len(u.Password) < 60 {
+	if u.Password != "" && !utils.IsPasswordHash(u.Password) {
 		hashed, err := utils.HashPassword(u.Password)
 		if err != nil {
 			return err
@@ -41,6 +69,12 @@ func (u *User) BeforeSave(tx *gorm.DB) (err error) {
 	return
 }
 
+// IsPendingInvite reports whether the user was invited and hasn't accepted yet,
+// i.e. has no password
+func (u *User) IsPendingInvite() bool {
+	return u.Password == ""
+}
+
 // ComparePassword is a helper to verify login
 func (u *User) ComparePassword(plainPassword string) bool {
 	return utils.CheckPassword(plainPassword, u.Password)
@@ -0,0 +1,125 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"starter-kit-grpc-golang/internal/models"
+	"starter-kit-grpc-golang/pkg/utils"
+
+	"gorm.io/gorm"
+)
+
+// maxAppendAttempts bounds retries when another writer extends the chain concurrently
+const maxAppendAttempts = 5
+
+// auditChainHeadID is the primary key of the single chain head row
+const auditChainHeadID = 1
+
+type auditRepository struct {
+	db  *gorm.DB
+	key []byte     // Keys the chain hash, see models.AuditEvent.ComputeHash
+	mu  sync.Mutex // Serializes appends within this process
+}
+
+func NewAuditRepository(db *gorm.DB, secret string) AuditRepository {
+	return &auditRepository{db: db, key: []byte(secret)}
+}
+
+// Append links the event to the current chain head, inserts it with the next sequence ID
+// and moves the head. The primary key and the unique index on prev_hash reject forks from
+// other instances, in which case we retry.
+func (r *auditRepository) Append(ctx context.Context, event *models.AuditEvent) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if event.CreatedAt.IsZero() {
+		// Microsecond precision survives a round-trip through every supported driver
+		event.CreatedAt = time.Now().UTC().Truncate(time.Microsecond)
+	}
+
+	var err error
+	for attempt := 0; attempt < maxAppendAttempts; attempt++ {
+		err = r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+			var head models.AuditChainHead
+			err := tx.Take(&head, auditChainHeadID).Error
+			if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+				return err
+			}
+
+			// The ID is part of the hash, so it is assigned here rather than by the database
+			event.ID = head.EventID + 1
+			event.PrevHash = head.Hash
+			event.Hash = event.ComputeHash(r.key)
+			if err := tx.Create(event).Error; err != nil {
+				return err
+			}
+
+			head = models.AuditChainHead{ID: auditChainHeadID, EventID: event.ID, Hash: event.Hash}
+			return tx.Save(&head).Error
+		})
+		if err == nil || ctx.Err() != nil {
+			return err
+		}
+	}
+	return err
+}
+
+func (r *auditRepository) Head(ctx context.Context) (*models.AuditChainHead, error) {
+	var head models.AuditChainHead
+	err := r.db.WithContext(ctx).Take(&head, auditChainHeadID).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &head, nil
+}
+
+func (r *auditRepository) FindAll(ctx context.Context, filter AuditFilter, pagination *utils.PaginationScope) ([]models.AuditEvent, int64, error) {
+	var events []models.AuditEvent
+	var totalRows int64
+
+	query := filter.apply(r.db.WithContext(ctx).Model(&models.AuditEvent{}))
+	query.Count(&totalRows)
+
+	err := query.
+		Order("id desc").
+		Scopes(pagination.Paginate()).
+		Find(&events).Error
+
+	return events, totalRows, err
+}
+
+// Iterate walks matching events in chain order, batchSize rows at a time
+func (r *auditRepository) Iterate(ctx context.Context, filter AuditFilter, batchSize int, fn func(batch []models.AuditEvent) error) error {
+	var batch []models.AuditEvent
+	// FindInBatches pages by primary key, which is chain order
+	result := filter.apply(r.db.WithContext(ctx).Model(&models.AuditEvent{})).
+		FindInBatches(&batch, batchSize, func(tx *gorm.DB, _ int) error {
+			return fn(batch)
+		})
+	return result.Error
+}
+
+func (f AuditFilter) apply(query *gorm.DB) *gorm.DB {
+	if f.ActorID != "" {
+		query = query.Where("actor_id = ?", f.ActorID)
+	}
+	if f.TargetID != "" {
+		query = query.Where("target_id = ?", f.TargetID)
+	}
+	if len(f.EventTypes) > 0 {
+		query = query.Where("event_type IN ?", f.EventTypes)
+	}
+	if !f.From.IsZero() {
+		query = query.Where("created_at >= ?", f.From)
+	}
+	if !f.To.IsZero() {
+		query = query.Where("created_at < ?", f.To)
+	}
+	return query
+}
\ No newline at end of file
@@ -0,0 +1,83 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"starter-kit-grpc-golang/internal/models"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type idempotencyRepository struct {
+	db *gorm.DB
+}
+
+func NewIdempotencyRepository(db *gorm.DB) IdempotencyRepository {
+	return &idempotencyRepository{db}
+}
+
+func (r *idempotencyRepository) Reserve(ctx context.Context, key *models.IdempotencyKey) (*models.IdempotencyKey, error) {
+	db := r.db.WithContext(ctx)
+	for attempt := 0; attempt < 2; attempt++ {
+		// The primary key makes the reservation atomic across concurrent retries
+		result := db.Clauses(clause.OnConflict{DoNothing: true}).Create(key)
+		if result.Error != nil {
+			return nil, result.Error
+		}
+		if result.RowsAffected == 1 {
+			return nil, nil
+		}
+
+		var existing models.IdempotencyKey
+		err := db.Where("id = ?", key.ID).First(&existing).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			continue // Released in the meantime
+		}
+		if err != nil {
+			return nil, err
+		}
+		if existing.ExpiresAt.After(time.Now()) {
+			return &existing, nil
+		}
+
+		// Expired but not purged yet: take it over
+		if err := db.Where("id = ? AND expires_at = ?", existing.ID, existing.ExpiresAt).Delete(&models.IdempotencyKey{}).Error; err != nil {
+			return nil, err
+		}
+	}
+	return nil, errors.New("could not reserve idempotency key")
+}
+
+func (r *idempotencyRepository) Complete(ctx context.Context, key *models.IdempotencyKey, response []byte, headers string, expiresAt time.Time) error {
+	// Compare-and-swap on the lease: a request that outlived it must not overwrite the
+	// record of the retry that took the key over
+	result := r.db.WithContext(ctx).Model(&models.IdempotencyKey{}).
+		Where("id = ? AND status = ? AND lease_id = ? AND fingerprint = ?", key.ID, models.IdempotencyPending, key.LeaseID, key.Fingerprint).
+		Updates(map[string]interface{}{
+			"status":     models.IdempotencyCompleted,
+			"response":   response,
+			"headers":    headers,
+			"expires_at": expiresAt,
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrLeaseLost
+	}
+	return nil
+}
+
+func (r *idempotencyRepository) Release(ctx context.Context, key *models.IdempotencyKey) error {
+	return r.db.WithContext(ctx).
+		Where("id = ? AND status = ? AND lease_id = ?", key.ID, models.IdempotencyPending, key.LeaseID).
+		Delete(&models.IdempotencyKey{}).Error
+}
+
+func (r *idempotencyRepository) PurgeExpired(ctx context.Context, now time.Time) (int64, error) {
+	result := r.db.WithContext(ctx).Where("expires_at < ?", now).Delete(&models.IdempotencyKey{})
+	return result.RowsAffected, result.Error
+}
\ No newline at end of file
@@ -0,0 +1,59 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"starter-kit-grpc-golang/internal/models"
+	"starter-kit-grpc-golang/internal/testutil"
+)
+
+func TestIdempotencyCompleteAfterLeaseLost(t *testing.T) {
+	ctx := context.Background()
+	repo := NewIdempotencyRepository(testutil.NewDB(t))
+
+	reserve := func(leaseID string, expiresAt time.Time) *models.IdempotencyKey {
+		t.Helper()
+		key := &models.IdempotencyKey{
+			ID:          "key-1",
+			Method:      "/v1.UserService/CreateUser",
+			Fingerprint: "request",
+			Status:      models.IdempotencyPending,
+			LeaseID:     leaseID,
+			ExpiresAt:   expiresAt,
+		}
+		if existing, err := repo.Reserve(ctx, key); err != nil || existing != nil {
+			t.Fatalf("reserve %s: existing %v, error %v", leaseID, existing, err)
+		}
+		return key
+	}
+
+	// The first request outlives its lease and a retry takes the key over
+	stale := reserve("first", time.Now().Add(-time.Second))
+	current := reserve("retry", time.Now().Add(time.Minute))
+
+	if err := repo.Complete(ctx, stale, []byte("first"), "", time.Now().Add(time.Hour)); !errors.Is(err, ErrLeaseLost) {
+		t.Errorf("complete with a lost lease: error %v, want ErrLeaseLost", err)
+	}
+	if err := repo.Release(ctx, stale); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := repo.Complete(ctx, current, []byte("retry"), "", time.Now().Add(time.Hour)); err != nil {
+		t.Fatalf("complete by the current holder: %v", err)
+	}
+	// Completing twice is a conflict too: the record is no longer pending
+	if err := repo.Complete(ctx, current, []byte("again"), "", time.Now().Add(time.Hour)); !errors.Is(err, ErrLeaseLost) {
+		t.Errorf("second complete: error %v, want ErrLeaseLost", err)
+	}
+
+	stored, err := repo.Reserve(ctx, &models.IdempotencyKey{ID: "key-1", Method: "m", Fingerprint: "request", Status: models.IdempotencyPending, ExpiresAt: time.Now().Add(time.Minute)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stored == nil || string(stored.Response) != "retry" {
+		t.Errorf("stored record %+v, want the retry's response", stored)
+	}
+}
\ No newline at end of file
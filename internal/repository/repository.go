@@ -1,23 +1,104 @@
 package repository
 
 import (
+	"context"
+	"errors"
+	"time"
+
 	"starter-kit-grpc-golang/internal/models"
 	"starter-kit-grpc-golang/pkg/utils"
+
+	"gorm.io/gorm/clause"
 )
 
 type UserRepository interface {
-	Create(user *models.User) error
-	FindByEmail(email string) (*models.User, error)
-	FindByID(id string) (*models.User, error)
-	FindAll(filters map[string]interface{}, pagination *utils.PaginationScope) ([]models.User, int64, error)
-	ExistsByEmail(email string) (bool, error)
-	Update(user *models.User) error
-	Delete(id string) error
+	Create(ctx context.Context, user *models.User) error
+	FindByEmail(ctx context.Context, email string) (*models.User, error)
+	FindByID(ctx context.Context, id string) (*models.User, error)
+	FindByIDs(ctx context.Context, ids []string) ([]models.User, error)
+	// FindAll returns the page, the total (unless pagination.SkipCount) and, in keyset mode,
+	// the cursor for the next page (nil on the last page)
+	FindAll(ctx context.Context, filter UserFilter, pagination *utils.PaginationScope) ([]models.User, int64, *utils.Cursor, error)
+	FindStatusByID(ctx context.Context, id string) (string, error)
+	ExistsByEmail(ctx context.Context, email string) (bool, error)
+	// Update saves user only if its version is unchanged since it was read and bumps the version.
+	// It returns ErrVersionConflict if another write got there first.
+	Update(ctx context.Context, user *models.User) error
+	// Delete soft-deletes the user. A non-zero version makes it conditional (ErrVersionConflict).
+	Delete(ctx context.Context, id string, version int64) error
+
+	FindDeleted(ctx context.Context, pagination *utils.PaginationScope) ([]models.User, int64, error)
+	Restore(ctx context.Context, id string) error
+	PurgeDeletedBefore(ctx context.Context, cutoff time.Time) (int64, error)
+	// Purge permanently removes the user, deleted or not. Delete its tokens first.
+	Purge(ctx context.Context, id string) error
+}
+
+// ErrVersionConflict reports a conditional write against a stale version
+var ErrVersionConflict = errors.New("version conflict")
+
+// ErrLeaseLost is returned when an idempotency key is no longer held by the caller's reservation
+var ErrLeaseLost = errors.New("idempotency key lease was lost")
+
+// Transactor runs fn in a DB transaction with repositories bound to it.
+// The transaction is rolled back if ctx is cancelled before it commits.
+// Calling Transaction on tx.Tx nests a savepoint, so a failed item can be
+// rolled back without aborting the whole transaction.
+type Transactor interface {
+	Transaction(ctx context.Context, fn func(tx TxRepositories) error) error
+}
+
+// TxRepositories are the repositories available inside a transaction
+type TxRepositories struct {
+	Users  UserRepository
+	Tokens TokenRepository
+	Tx     Transactor
+}
+
+// UserFilter narrows user listings; zero values are ignored
+type UserFilter struct {
+	Search string
+	Scope  string // "name", "email", "id" or "all"
+	Role   string
+	// Where is a parsed filter expression whose columns are already allowlisted
+	Where clause.Expression
 }
 
 type TokenRepository interface {
-	Create(token *models.Token) error
-	FindByToken(token string, tokenType string) (*models.Token, error)
-	DeleteByUserIDAndType(userID string, tokenType string) error
-	Delete(token *models.Token) error
+	Create(ctx context.Context, token *models.Token) error
+	FindByToken(ctx context.Context, token string, tokenType string) (*models.Token, error)
+	DeleteByUserIDAndType(ctx context.Context, userID string, tokenType string) error
+	DeleteByUserID(ctx context.Context, userID string) error
+	Delete(ctx context.Context, token *models.Token) error
+}
+
+type IdempotencyRepository interface {
+	// Reserve stores key as pending. If an unexpired record with the same ID exists,
+	// nothing is stored and the existing record is returned instead. Expired records,
+	// including pending ones whose request died, are taken over.
+	Reserve(ctx context.Context, key *models.IdempotencyKey) (*models.IdempotencyKey, error)
+	// Complete stores the response and keeps the record until expiresAt. It only updates
+	// the pending reservation made with key.LeaseID and returns ErrLeaseLost otherwise,
+	// e.g. when the lease expired and a retry took the key over.
+	Complete(ctx context.Context, key *models.IdempotencyKey, response []byte, headers string, expiresAt time.Time) error
+	// Release drops the pending reservation made with key.LeaseID so the request can be retried
+	Release(ctx context.Context, key *models.IdempotencyKey) error
+	PurgeExpired(ctx context.Context, now time.Time) (int64, error)
+}
+
+type AuditRepository interface {
+	Append(ctx context.Context, event *models.AuditEvent) error
+	// Head returns the latest event recorded by Append, or nil if nothing was appended
+	Head(ctx context.Context) (*models.AuditChainHead, error)
+	FindAll(ctx context.Context, filter AuditFilter, pagination *utils.PaginationScope) ([]models.AuditEvent, int64, error)
+	Iterate(ctx context.Context, filter AuditFilter, batchSize int, fn func(batch []models.AuditEvent) error) error
+}
+
+// AuditFilter narrows audit queries; zero values are ignored
+type AuditFilter struct {
+	ActorID    string
+	TargetID   string
+	EventTypes []string
+	From       time.Time
+	To         time.Time
 }
\ No newline at end of file
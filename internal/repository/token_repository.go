@@ -1,6 +1,7 @@
 package repository
 
 import (
+	"context"
 	"starter-kit-grpc-golang/internal/models"
 
 	"gorm.io/gorm"
@@ -14,23 +15,27 @@ func NewTokenRepository(db *gorm.DB) TokenRepository {
 	return &tokenRepository{db}
 }
 
-func (r *tokenRepository) Create(token *models.Token) error {
-	return r.db.Create(token).Error
+func (r *tokenRepository) Create(ctx context.Context, token *models.Token) error {
+	return r.db.WithContext(ctx).Create(token).Error
 }
 
-func (r *tokenRepository) FindByToken(tokenStr string, tokenType string) (*models.Token, error) {
+func (r *tokenRepository) FindByToken(ctx context.Context, tokenStr string, tokenType string) (*models.Token, error) {
 	var token models.Token
-	err := r.db.Where("token = ? AND type = ? AND blacklisted = ?", tokenStr, tokenType, false).First(&token).Error
+	err := r.db.WithContext(ctx).Where("token = ? AND type = ? AND blacklisted = ?", tokenStr, tokenType, false).First(&token).Error
 	if err != nil {
 		return nil, err
 	}
 	return &token, nil
 }
 
-func (r *tokenRepository) DeleteByUserIDAndType(userID string, tokenType string) error {
-	return r.db.Where("user_id = ? AND type = ?", userID, tokenType).Delete(&models.Token{}).Error
+func (r *tokenRepository) DeleteByUserIDAndType(ctx context.Context, userID string, tokenType string) error {
+	return r.db.WithContext(ctx).Where("user_id = ? AND type = ?", userID, tokenType).Delete(&models.Token{}).Error
 }
 
-func (r *tokenRepository) Delete(token *models.Token) error {
-	return r.db.Delete(token).Error
+func (r *tokenRepository) DeleteByUserID(ctx context.Context, userID string) error {
+	return r.db.WithContext(ctx).Where("user_id = ?", userID).Delete(&models.Token{}).Error
+}
+
+func (r *tokenRepository) Delete(ctx context.Context, token *models.Token) error {
+	return r.db.WithContext(ctx).Delete(token).Error
 }
\ No newline at end of file
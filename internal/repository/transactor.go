@@ -0,0 +1,25 @@
+package repository
+
+import (
+	"context"
+	"gorm.io/gorm"
+)
+
+type transactor struct {
+	db *gorm.DB
+}
+
+func NewTransactor(db *gorm.DB) Transactor {
+	return &transactor{db}
+}
+
+func (t *transactor) Transaction(ctx context.Context, fn func(tx TxRepositories) error) error {
+	// GORM turns a Transaction inside a transaction into a SAVEPOINT
+	return t.db.WithContext(ctx).Transaction(func(db *gorm.DB) error {
+		return fn(TxRepositories{
+			Users:  NewUserRepository(db),
+			Tokens: NewTokenRepository(db),
+			Tx:     &transactor{db},
+		})
+	})
+}
\ No newline at end of file
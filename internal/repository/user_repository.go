@@ -1,61 +1,107 @@
 package repository
 
 import (
-	"strings"
+	"context"
+	"time"
 
 	"starter-kit-grpc-golang/internal/models"
 	"starter-kit-grpc-golang/pkg/utils"
 
 	"github.com/google/uuid"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type userRepository struct {
-	db *gorm.DB
+	db     *gorm.DB
+	search userSearch
 }
 
 func NewUserRepository(db *gorm.DB) UserRepository {
-	return &userRepository{db}
+	return &userRepository{db: db, search: newUserSearch(db)}
 }
 
-func (r *userRepository) Create(user *models.User) error {
-	return r.db.Create(user).Error
+func (r *userRepository) Create(ctx context.Context, user *models.User) error {
+	return r.db.WithContext(ctx).Create(user).Error
 }
 
-func (r *userRepository) FindByEmail(email string) (*models.User, error) {
+func (r *userRepository) FindByEmail(ctx context.Context, email string) (*models.User, error) {
 	var user models.User
-	err := r.db.Where("email = ?", email).First(&user).Error
+	err := r.db.WithContext(ctx).Where("email = ?", email).First(&user).Error
 	if err != nil {
 		return nil, err
 	}
 	return &user, nil
 }
 
-func (r *userRepository) FindByID(id string) (*models.User, error) {
+func (r *userRepository) FindByID(ctx context.Context, id string) (*models.User, error) {
 	var user models.User
-	err := r.db.Where("id = ?", id).First(&user).Error
+	err := r.db.WithContext(ctx).Where("id = ?", id).First(&user).Error
 	if err != nil {
 		return nil, err
 	}
 	return &user, nil
 }
 
-func (r *userRepository) FindAll(filters map[string]interface{}, pagination *utils.PaginationScope) ([]models.User, int64, error) {
+// FindByIDs returns the users found, in no particular order
+func (r *userRepository) FindByIDs(ctx context.Context, ids []string) ([]models.User, error) {
+	var users []models.User
+	err := r.db.WithContext(ctx).Where("id IN ?", ids).Find(&users).Error
+	return users, err
+}
+
+// userSortFields maps allowed sort fields to columns.
+// Supports both snake_case (DB/Proto) and camelCase (JSON)
+var userSortFields = map[string]string{
+	"id":         "id",
+	"name":       "name",
+	"email":      "email",
+	"role":       "role",
+	"status":     "status",
+	"created_at": "created_at",
+	"createdAt":  "created_at",
+	"updated_at": "updated_at",
+	"updatedAt":  "updated_at",
+}
+
+// userSortValue returns the value of a sortable column, used to build page cursors
+func userSortValue(u *models.User, column string) interface{} {
+	switch column {
+	case "id":
+		return u.ID
+	case "name":
+		return u.Name
+	case "email":
+		return u.Email
+	case "role":
+		return u.Role
+	case "status":
+		return u.Status
+	case "created_at":
+		return u.CreatedAt
+	case "updated_at":
+		return u.UpdatedAt
+	}
+	return nil
+}
+
+func (r *userRepository) FindAll(ctx context.Context, filter UserFilter, pagination *utils.PaginationScope) ([]models.User, int64, *utils.Cursor, error) {
 	var users []models.User
 	var totalRows int64
 
-	query := r.db.Model(&models.User{})
+	query := r.db.WithContext(ctx).Model(&models.User{})
 
 	// --- 1. SEARCH LOGIC ---
-	if search, ok := filters["search"].(string); ok && search != "" {
-		scope, _ := filters["scope"].(string)
-		searchPattern := "%" + strings.ToLower(search) + "%"
-
-		switch scope {
+	// Full-text prefix search, ranked by relevance unless an explicit sort was requested
+	var rank *clause.OrderBy
+	if search := filter.Search; search != "" {
+		var columns []string
+		var id string
+		switch filter.Scope {
 		case "name":
-			query = query.Where("lower(name) LIKE ?", searchPattern)
+			columns = []string{"name"}
 		case "email":
-			query = query.Where("lower(email) LIKE ?", searchPattern)
+			columns = []string{"email"}
 		case "id":
 			// If searching by ID, exact match is expected
 			// If using UUID, ensure it's valid to avoid DB errors
@@ -69,34 +115,162 @@ func (r *userRepository) FindAll(filters map[string]interface{}, pagination *uti
 			fallthrough
 		default:
 			// OR Logic: Name OR Email OR ID
-			subQuery := r.db.Where("lower(name) LIKE ?", searchPattern).
-				Or("lower(email) LIKE ?", searchPattern)
-
+			columns = []string{"name", "email"}
 			// Only append ID check if it looks like a valid UUID
 			if _, err := uuid.Parse(search); err == nil {
-				subQuery = subQuery.Or("id = ?", search)
+				id = search
+			}
+		}
+
+		if columns != nil {
+			if terms := searchTerms(search); len(terms) > 0 {
+				var order clause.OrderBy
+				query, order = r.search.Match(query, terms, columns, id)
+				if pagination.Sort == "" {
+					rank = &order
+				}
+			} else if id != "" {
+				query = query.Where("users.id = ?", id)
+			} else {
+				query = query.Where("1 = 0")
 			}
-			query = query.Where(subQuery)
 		}
 	}
 
 	// --- 2. FILTER LOGIC ---
-	if role, ok := filters["role"].(string); ok && role != "" {
-		query = query.Where("role = ?", role)
+	if filter.Role != "" {
+		query = query.Where("role = ?", filter.Role)
+	}
+	if filter.Where != nil {
+		query = query.Where(filter.Where)
 	}
 
 	// --- 3. COUNT TOTAL ---
-	query.Count(&totalRows)
+	if !pagination.SkipCount {
+		query.Count(&totalRows)
+	}
 
 	// --- 4. SORTING & PAGINATION ---
-	// Map allowed fields. Support both snake_case (DB/Proto) and camelCase (JSON)
+	if rank != nil {
+		return r.findRanked(query, *rank, pagination, totalRows)
+	}
+
+	if pagination.Keyset {
+		// Seek past the cursor instead of OFFSET, so pages stay stable under inserts
+		if err := query.Scopes(pagination.KeysetScope(userSortFields)).Find(&users).Error; err != nil {
+			return nil, 0, nil, err
+		}
+
+		var next *utils.Cursor
+		if size := pagination.PageSize(); len(users) > size {
+			users = users[:size]
+			last := &users[size-1]
+			next = pagination.NextCursor(userSortFields, last.ID, func(column string) interface{} {
+				return userSortValue(last, column)
+			})
+		}
+		return users, totalRows, next, nil
+	}
+
+	err := query.
+		Scopes(pagination.SortScope(userSortFields)).
+		Scopes(pagination.Paginate()).
+		Find(&users).Error
+
+	return users, totalRows, nil, err
+}
+
+// findRanked pages through relevance-ordered search results. Rank can't be seeked
+// like a column, so page tokens carry an offset instead.
+func (r *userRepository) findRanked(query *gorm.DB, rank clause.OrderBy, pagination *utils.PaginationScope, totalRows int64) ([]models.User, int64, *utils.Cursor, error) {
+	var users []models.User
+	query = query.Order(rank).Order("users.id")
+
+	if !pagination.Keyset {
+		err := query.Scopes(pagination.Paginate()).Find(&users).Error
+		return users, totalRows, nil, err
+	}
+
+	offset := 0
+	if pagination.After != nil {
+		offset = pagination.After.Offset
+	}
+	size := pagination.PageSize()
+	if err := query.Offset(offset).Limit(size + 1).Find(&users).Error; err != nil {
+		return nil, 0, nil, err
+	}
+
+	var next *utils.Cursor
+	if len(users) > size {
+		users = users[:size]
+		next = &utils.Cursor{ID: users[size-1].ID, Offset: offset + size}
+	}
+	return users, totalRows, next, nil
+}
+
+func (r *userRepository) FindStatusByID(ctx context.Context, id string) (string, error) {
+	var user models.User
+	err := r.db.WithContext(ctx).Select("status").Where("id = ?", id).First(&user).Error
+	if err != nil {
+		return "", err
+	}
+	return user.Status, nil
+}
+
+// ExistsByEmail includes soft-deleted users, since their email stays reserved until purge
+func (r *userRepository) ExistsByEmail(ctx context.Context, email string) (bool, error) {
+	var count int64
+	err := r.db.WithContext(ctx).Unscoped().Model(&models.User{}).Where("email = ?", email).Count(&count).Error
+	return count > 0, err
+}
+
+func (r *userRepository) Update(ctx context.Context, user *models.User) error {
+	// Compare-and-swap on version instead of Save, so concurrent edits can't overwrite each other
+	expected := user.Version
+	user.Version++
+	result := r.db.WithContext(ctx).Model(user).Where("version = ?", expected).Select("*").Updates(user)
+	if result.Error != nil {
+		user.Version = expected
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		user.Version = expected
+		return ErrVersionConflict
+	}
+	return nil
+}
+
+func (r *userRepository) Delete(ctx context.Context, id string, version int64) error {
+	query := r.db.WithContext(ctx).Where("id = ?", id)
+	if version != 0 {
+		query = query.Where("version = ?", version)
+	}
+	result := query.Delete(&models.User{})
+	if result.Error != nil {
+		return result.Error
+	}
+	if version != 0 && result.RowsAffected == 0 {
+		return ErrVersionConflict
+	}
+	return nil
+}
+
+func (r *userRepository) FindDeleted(ctx context.Context, pagination *utils.PaginationScope) ([]models.User, int64, error) {
+	var users []models.User
+	var totalRows int64
+
+	query := r.db.WithContext(ctx).Unscoped().Model(&models.User{}).Where("deleted_at IS NOT NULL")
+	query.Count(&totalRows)
+
+	// Includes the default created_at and the id tiebreaker, so they can be requested too
 	allowedSortFields := map[string]string{
 		"id":         "id",
 		"name":       "name",
 		"email":      "email",
-		"role":       "role",
 		"created_at": "created_at",
 		"createdAt":  "created_at",
+		"deleted_at": "deleted_at",
+		"deletedAt":  "deleted_at",
 	}
 
 	err := query.
@@ -107,16 +281,28 @@ func (r *userRepository) FindAll(filters map[string]interface{}, pagination *uti
 	return users, totalRows, err
 }
 
-func (r *userRepository) ExistsByEmail(email string) (bool, error) {
-	var count int64
-	err := r.db.Model(&models.User{}).Where("email = ?", email).Count(&count).Error
-	return count > 0, err
+func (r *userRepository) Restore(ctx context.Context, id string) error {
+	result := r.db.WithContext(ctx).Unscoped().Model(&models.User{}).
+		Where("id = ? AND deleted_at IS NOT NULL", id).
+		Updates(map[string]interface{}{"deleted_at": nil, "version": gorm.Expr("version + 1")})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
 }
 
-func (r *userRepository) Update(user *models.User) error {
-	return r.db.Save(user).Error
+// PurgeDeletedBefore permanently removes users soft-deleted before cutoff
+func (r *userRepository) PurgeDeletedBefore(ctx context.Context, cutoff time.Time) (int64, error) {
+	result := r.db.WithContext(ctx).Unscoped().
+		Where("deleted_at IS NOT NULL AND deleted_at < ?", cutoff).
+		Delete(&models.User{})
+	return result.RowsAffected, result.Error
 }
 
-func (r *userRepository) Delete(id string) error {
-	return r.db.Delete(&models.User{}, "id = ?", id).Error
+// Purge permanently removes a user, whether soft-deleted or not
+func (r *userRepository) Purge(ctx context.Context, id string) error {
+	return r.db.WithContext(ctx).Unscoped().Where("id = ?", id).Delete(&models.User{}).Error
 }
\ No newline at end of file
@@ -0,0 +1,126 @@
+package repository
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// maxSearchTerms bounds the size of the generated full-text query
+const maxSearchTerms = 8
+
+// userSearch is the driver-specific full-text backend behind FindAll's search.
+// The indexes it queries are created by config.migrateUserSearch.
+type userSearch interface {
+	// Match keeps users where every term prefix-matches one of the columns
+	// ("name", "email"), or whose id equals id when id is not empty.
+	// It returns the ORDER BY that ranks the most relevant users first.
+	Match(query *gorm.DB, terms, columns []string, id string) (*gorm.DB, clause.OrderBy)
+}
+
+func newUserSearch(db *gorm.DB) userSearch {
+	switch db.Dialector.Name() {
+	case "postgres":
+		return postgresUserSearch{}
+	case "sqlite":
+		return sqliteUserSearch{}
+	}
+	return likeUserSearch{}
+}
+
+// searchTerms splits the search string into lowercase words, dropping punctuation
+// so the terms are always safe to embed in tsquery/FTS5 syntax
+func searchTerms(search string) []string {
+	terms := strings.FieldsFunc(strings.ToLower(search), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	if len(terms) > maxSearchTerms {
+		terms = terms[:maxSearchTerms]
+	}
+	return terms
+}
+
+// orID widens a match condition with an exact id match
+func orID(condition clause.Expression, id string) clause.Expression {
+	if id == "" {
+		return condition
+	}
+	return clause.Or(condition, clause.Eq{Column: clause.Column{Table: "users", Name: "id"}, Value: id})
+}
+
+// --- Postgres: tsvector + GIN ---
+
+type postgresUserSearch struct{}
+
+func (postgresUserSearch) Match(query *gorm.DB, terms, columns []string, id string) (*gorm.DB, clause.OrderBy) {
+	// Weights restrict a lexeme to a column: A = name, B = email
+	weights := ""
+	for _, column := range columns {
+		switch column {
+		case "name":
+			weights += "A"
+		case "email":
+			weights += "B"
+		}
+	}
+
+	lexemes := make([]string, len(terms))
+	for i, term := range terms {
+		lexemes[i] = term + ":*" + weights
+	}
+	tsquery := strings.Join(lexemes, " & ")
+
+	match := clause.Expr{SQL: "users.search_vector @@ to_tsquery('simple', ?)", Vars: []interface{}{tsquery}}
+	rank := clause.OrderBy{Expression: clause.Expr{
+		SQL:                "ts_rank(users.search_vector, to_tsquery('simple', ?)) DESC",
+		Vars:               []interface{}{tsquery},
+		WithoutParentheses: true,
+	}}
+	return query.Where(orID(match, id)), rank
+}
+
+// --- SQLite: FTS5 ---
+
+type sqliteUserSearch struct{}
+
+func (sqliteUserSearch) Match(query *gorm.DB, terms, columns []string, id string) (*gorm.DB, clause.OrderBy) {
+	// e.g. {name email} : "bob"* AND {name email} : "exa"*
+	filter := "{" + strings.Join(columns, " ") + "}"
+	phrases := make([]string, len(terms))
+	for i, term := range terms {
+		phrases[i] = fmt.Sprintf(`%s : "%s"*`, filter, term)
+	}
+	expression := strings.Join(phrases, " AND ")
+
+	// bm25 is lower-is-better; name hits weigh more than email hits
+	query = query.Joins(
+		"LEFT JOIN (SELECT rowid AS fts_rowid, bm25(users_fts, 10.0, 5.0) AS fts_rank FROM users_fts WHERE users_fts MATCH ?) AS fts ON fts.fts_rowid = users.rowid",
+		expression,
+	)
+	match := clause.Expr{SQL: "fts.fts_rowid IS NOT NULL"}
+	rank := clause.OrderBy{Expression: clause.Expr{SQL: "fts.fts_rank ASC", WithoutParentheses: true}}
+	return query.Where(orID(match, id)), rank
+}
+
+// --- Fallback: LIKE (unranked) ---
+
+type likeUserSearch struct{}
+
+func (likeUserSearch) Match(query *gorm.DB, terms, columns []string, id string) (*gorm.DB, clause.OrderBy) {
+	var ands []clause.Expression
+	for _, term := range terms {
+		var ors []clause.Expression
+		for _, column := range columns {
+			ors = append(ors, clause.Expr{
+				SQL:  "lower(?) LIKE ?",
+				Vars: []interface{}{clause.Column{Name: column}, term + "%"},
+			})
+		}
+		ands = append(ands, clause.Or(ors...))
+	}
+	rank := clause.OrderBy{Columns: []clause.OrderByColumn{{Column: clause.Column{Name: "created_at"}, Desc: true}}}
+	return query.Where(orID(clause.And(ands...), id)), rank
+}
\ No newline at end of file
@@ -0,0 +1,65 @@
+// Package requestctx holds the per-request values set by the interceptors, such as
+// the authenticated caller, so layers below the transport can read them without
+// depending on the interceptor package.
+package requestctx
+
+import (
+	"context"
+	"net"
+
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+type Key string
+
+// Context keys of the authenticated caller, set by the auth interceptor
+const (
+	UserIDKey        Key = "userID"
+	RoleKey          Key = "role"
+	EmailVerifiedKey Key = "emailVerified"
+)
+
+// UserID returns the authenticated caller's ID, or "" if the request is unauthenticated
+func UserID(ctx context.Context) string {
+	userID, _ := ctx.Value(UserIDKey).(string)
+	return userID
+}
+
+// ClientIP attempts to resolve IP from Metadata (Gateway) or Peer (Direct gRPC)
+func ClientIP(ctx context.Context) string {
+	// 1. Try X-Forwarded-For (From HTTP Gateway)
+	md, ok := metadata.FromIncomingContext(ctx)
+	if ok {
+		vals := md.Get("x-forwarded-for")
+		if len(vals) > 0 {
+			return vals[0]
+		}
+	}
+
+	// 2. Try Peer Info
+	if p, ok := peer.FromContext(ctx); ok {
+		host, _, err := net.SplitHostPort(p.Addr.String())
+		if err == nil {
+			return host
+		}
+		return p.Addr.String()
+	}
+
+	return "unknown"
+}
+
+// ClientInfo returns the caller's IP and user agent, preferring values forwarded by the HTTP Gateway
+func ClientInfo(ctx context.Context) (ip string, userAgent string) {
+	ip = ClientIP(ctx)
+
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		// grpc-gateway forwards the browser's User-Agent with a prefix
+		if vals := md.Get("grpcgateway-user-agent"); len(vals) > 0 {
+			userAgent = vals[0]
+		} else if vals := md.Get("user-agent"); len(vals) > 0 {
+			userAgent = vals[0]
+		}
+	}
+	return ip, userAgent
+}
\ No newline at end of file
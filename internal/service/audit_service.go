@@ -0,0 +1,149 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	"starter-kit-grpc-golang/internal/models"
+	"starter-kit-grpc-golang/internal/repository"
+	"starter-kit-grpc-golang/internal/requestctx"
+	"starter-kit-grpc-golang/pkg/logger"
+	"starter-kit-grpc-golang/pkg/utils"
+)
+
+type AuditService interface {
+	Record(ctx context.Context, entry AuditEntry)
+	ListEvents(ctx context.Context, filter repository.AuditFilter, page, limit int32) ([]models.AuditEvent, int64, error)
+	ExportEvents(ctx context.Context, filter repository.AuditFilter, fn func(event *models.AuditEvent) error) error
+	VerifyChain(ctx context.Context) (*ChainVerification, error)
+}
+
+// AuditEntry describes an event to record. ActorID defaults to the authenticated caller.
+type AuditEntry struct {
+	Type     string
+	ActorID  string
+	TargetID string
+	Outcome  string // Defaults to success
+	Details  map[string]string
+}
+
+// ChainVerification is the result of walking the audit hash chain
+type ChainVerification struct {
+	Valid         bool
+	CheckedEvents int64
+	BrokenEventID uint // First event whose hash or link doesn't match, or the first missing one; 0 if valid
+}
+
+const auditExportBatchSize = 500
+
+// errChainBroken stops chain verification at the first mismatch
+var errChainBroken = errors.New("audit chain broken")
+
+type auditService struct {
+	repo repository.AuditRepository
+	key  []byte // Same secret the repository hashes the chain with
+}
+
+func NewAuditService(repo repository.AuditRepository, secret string) AuditService {
+	return &auditService{repo: repo, key: []byte(secret)}
+}
+
+// Record writes an audit event. Failures are logged, never returned,
+// so auditing can't break the operation being audited.
+func (s *auditService) Record(ctx context.Context, entry AuditEntry) {
+	if entry.ActorID == "" {
+		entry.ActorID = requestctx.UserID(ctx)
+	}
+	if entry.Outcome == "" {
+		entry.Outcome = models.AuditOutcomeSuccess
+	}
+
+	ip, userAgent := requestctx.ClientInfo(ctx)
+
+	var details string
+	if len(entry.Details) > 0 {
+		encoded, _ := json.Marshal(entry.Details) // Map keys are sorted, so output is stable
+		details = string(encoded)
+	}
+
+	event := &models.AuditEvent{
+		EventType: entry.Type,
+		ActorID:   entry.ActorID,
+		TargetID:  entry.TargetID,
+		IP:        ip,
+		UserAgent: userAgent,
+		Outcome:   entry.Outcome,
+		Details:   details,
+	}
+
+	// The audited change has already happened, so record it even if the caller has gone away
+	if err := s.repo.Append(context.WithoutCancel(ctx), event); err != nil {
+		logger.FromContext(ctx).Error("Failed to write audit event", "type", entry.Type, "error", err)
+	}
+}
+
+func (s *auditService) ListEvents(ctx context.Context, filter repository.AuditFilter, page, limit int32) ([]models.AuditEvent, int64, error) {
+	paginationScope := &utils.PaginationScope{
+		Page:  page,
+		Limit: limit,
+	}
+
+	return s.repo.FindAll(ctx, filter, paginationScope)
+}
+
+func (s *auditService) ExportEvents(ctx context.Context, filter repository.AuditFilter, fn func(event *models.AuditEvent) error) error {
+	return s.repo.Iterate(ctx, filter, auditExportBatchSize, func(batch []models.AuditEvent) error {
+		for i := range batch {
+			if err := fn(&batch[i]); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (s *auditService) VerifyChain(ctx context.Context) (*ChainVerification, error) {
+	// Read the head first: events appended while we walk are checked, but not required
+	head, err := s.repo.Head(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &ChainVerification{Valid: true}
+	var prev models.AuditEvent
+	reachedHead := head == nil
+
+	err = s.repo.Iterate(ctx, repository.AuditFilter{}, auditExportBatchSize, func(batch []models.AuditEvent) error {
+		for i := range batch {
+			event := &batch[i]
+			result.CheckedEvents++
+
+			if event.ID != prev.ID+1 || event.PrevHash != prev.Hash || event.Hash != event.ComputeHash(s.key) {
+				result.Valid = false
+				result.BrokenEventID = event.ID
+				return errChainBroken
+			}
+			if head != nil && event.ID == head.EventID {
+				if event.Hash != head.Hash {
+					result.Valid = false
+					result.BrokenEventID = event.ID
+					return errChainBroken
+				}
+				reachedHead = true
+			}
+			prev = *event
+		}
+		return nil
+	})
+	if err != nil && !errors.Is(err, errChainBroken) {
+		return nil, err
+	}
+
+	// Events were removed from the end of the chain, or the head was dropped
+	if result.Valid && (!reachedHead || head == nil && result.CheckedEvents > 0) {
+		result.Valid = false
+		result.BrokenEventID = prev.ID + 1
+	}
+	return result, nil
+}
\ No newline at end of file
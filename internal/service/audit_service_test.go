@@ -0,0 +1,154 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"starter-kit-grpc-golang/internal/models"
+	"starter-kit-grpc-golang/internal/repository"
+	"starter-kit-grpc-golang/internal/testutil"
+
+	"gorm.io/gorm"
+)
+
+const testAuditSecret = "audit-secret"
+
+// newAuditChain records n events and returns the service and its database
+func newAuditChain(t *testing.T, n int) (AuditService, *gorm.DB) {
+	t.Helper()
+	db := testutil.NewDB(t)
+	svc := NewAuditService(repository.NewAuditRepository(db, testAuditSecret), testAuditSecret)
+	for i := 0; i < n; i++ {
+		svc.Record(context.Background(), AuditEntry{
+			Type:     models.AuditUserUpdated,
+			ActorID:  "admin",
+			TargetID: fmt.Sprintf("user-%d", i),
+			Details:  map[string]string{"fields": "name"},
+		})
+	}
+	return svc, db
+}
+
+func verifyChain(t *testing.T, svc AuditService) *ChainVerification {
+	t.Helper()
+	result, err := svc.VerifyChain(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return result
+}
+
+func TestAuditChainLinksEvents(t *testing.T) {
+	_, db := newAuditChain(t, 3)
+
+	var events []models.AuditEvent
+	db.Order("id").Find(&events)
+	if len(events) != 3 {
+		t.Fatalf("got %d events, want 3", len(events))
+	}
+	prevHash := ""
+	for i, e := range events {
+		if e.ID != uint(i+1) {
+			t.Errorf("event %d has ID %d, want consecutive IDs", i, e.ID)
+		}
+		if e.PrevHash != prevHash || e.Hash != e.ComputeHash([]byte(testAuditSecret)) {
+			t.Errorf("event %d is not linked to the one before", e.ID)
+		}
+		prevHash = e.Hash
+	}
+
+	var head models.AuditChainHead
+	db.Take(&head)
+	if head.EventID != 3 || head.Hash != events[2].Hash {
+		t.Errorf("head = %+v, want event 3", head)
+	}
+}
+
+func TestAuditHash(t *testing.T) {
+	key := []byte(testAuditSecret)
+	event := models.AuditEvent{ID: 1, EventType: models.AuditLogout, UserAgent: "curl", Outcome: "success"}
+	hash := event.ComputeHash(key)
+
+	renumbered := event
+	renumbered.ID = 2
+	if renumbered.ComputeHash(key) == hash {
+		t.Error("hash does not change with the sequence ID")
+	}
+	if event.ComputeHash([]byte("guessed")) == hash {
+		t.Error("hash does not depend on the key")
+	}
+
+	// Moving a separator between fields must change the hash
+	shifted := event
+	shifted.UserAgent, shifted.Outcome = "curl|success", ""
+	if shifted.ComputeHash(key) == hash {
+		t.Error("hash is ambiguous across field boundaries")
+	}
+}
+
+func TestVerifyChain(t *testing.T) {
+	tests := []struct {
+		name   string
+		tamper func(db *gorm.DB)
+		broken uint // 0: the chain is valid
+	}{
+		{"untouched", func(db *gorm.DB) {}, 0},
+		{"edited column", func(db *gorm.DB) {
+			db.Model(&models.AuditEvent{}).Where("id = 3").Update("details", `{"fields":"role"}`)
+		}, 3},
+		{"edited and rehashed without the key", func(db *gorm.DB) {
+			var e models.AuditEvent
+			db.Take(&e, 3)
+			e.TargetID = "someone-else"
+			e.Hash = e.ComputeHash([]byte("guessed"))
+			db.Save(&e)
+		}, 3},
+		{"edited and rehashed with the key", func(db *gorm.DB) {
+			var e models.AuditEvent
+			db.Take(&e, 3)
+			e.TargetID = "someone-else"
+			e.Hash = e.ComputeHash([]byte(testAuditSecret))
+			db.Save(&e)
+		}, 4},
+		{"removed from the middle", func(db *gorm.DB) {
+			db.Delete(&models.AuditEvent{}, 2)
+		}, 3},
+		{"removed from the start", func(db *gorm.DB) {
+			db.Delete(&models.AuditEvent{}, 1)
+		}, 2},
+		{"renumbered", func(db *gorm.DB) {
+			db.Model(&models.AuditEvent{}).Where("id = 5").Update("id", 6)
+		}, 6},
+		{"removed from the end", func(db *gorm.DB) {
+			db.Where("id > 3").Delete(&models.AuditEvent{})
+		}, 4},
+		{"head dropped", func(db *gorm.DB) {
+			db.Where("1 = 1").Delete(&models.AuditChainHead{})
+		}, 6},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, db := newAuditChain(t, 5)
+			tt.tamper(db)
+
+			result := verifyChain(t, svc)
+			if result.Valid != (tt.broken == 0) || result.BrokenEventID != tt.broken {
+				t.Errorf("got valid=%v broken=%d, want broken=%d", result.Valid, result.BrokenEventID, tt.broken)
+			}
+		})
+	}
+}
+
+func TestVerifyChainEmptyAndGrowing(t *testing.T) {
+	svc, _ := newAuditChain(t, 0)
+	if result := verifyChain(t, svc); !result.Valid || result.CheckedEvents != 0 {
+		t.Errorf("empty chain: %+v, want valid", result)
+	}
+
+	svc.Record(context.Background(), AuditEntry{Type: models.AuditLogout})
+	svc.Record(context.Background(), AuditEntry{Type: models.AuditLogout})
+	if result := verifyChain(t, svc); !result.Valid || result.CheckedEvents != 2 {
+		t.Errorf("after two appends: %+v, want 2 valid events", result)
+	}
+}
\ No newline at end of file
@@ -1,48 +1,84 @@
 package service
 
 import (
-	"errors"
+	"context"
+	"strings"
 	"time"
 
 	"starter-kit-grpc-golang/config"
+	"starter-kit-grpc-golang/internal/metrics"
 	"starter-kit-grpc-golang/internal/models"
 	"starter-kit-grpc-golang/internal/repository"
+	"starter-kit-grpc-golang/pkg/logger"
+	"starter-kit-grpc-golang/pkg/redact"
 	"starter-kit-grpc-golang/pkg/utils"
 )
 
 type AuthService interface {
-	Login(email, password string) (*models.User, string, string, time.Time, time.Time, error)
-	Register(name, email, password string) (*models.User, string, string, time.Time, time.Time, error)
-	RefreshAuth(refreshToken string) (string, string, time.Time, time.Time, error)
-	Logout(refreshToken string) error
-	
-	ForgotPassword(email string) error
-	ResetPassword(token, newPassword string) error
-	SendVerificationEmail(userID string) error
-	VerifyEmail(token string) error
+	Login(ctx context.Context, email, password string) (*models.User, string, string, time.Time, time.Time, error)
+	Register(ctx context.Context, name, email, password string) (*models.User, string, string, time.Time, time.Time, error)
+	RefreshAuth(ctx context.Context, refreshToken string) (string, string, time.Time, time.Time, error)
+	Logout(ctx context.Context, refreshToken string) error
+
+	ForgotPassword(ctx context.Context, email string) error
+	ResetPassword(ctx context.Context, token, newPassword string) error
+	SendVerificationEmail(ctx context.Context, userID string) error
+	// VerifyEmail marks the email verified. The claim reaches access tokens on the next RefreshAuth.
+	VerifyEmail(ctx context.Context, token string) error
+
+	InviteUser(ctx context.Context, email, name, role string) (*models.User, error)
+	AcceptInvite(ctx context.Context, token, name, password string) (*models.User, string, string, time.Time, time.Time, error)
 }
 
+var (
+	ErrRegistrationClosed = &Error{Kind: KindPermissionDenied, Reason: "REGISTRATION_CLOSED", Message: "registration is by invitation only"}
+	ErrEmailDomainBlocked = &Error{Kind: KindPermissionDenied, Reason: "EMAIL_DOMAIN_NOT_ALLOWED", Message: "email domain is not allowed to register"}
+	ErrAccountSuspended   = &Error{Kind: KindPermissionDenied, Reason: "ACCOUNT_SUSPENDED", Message: "account is suspended"}
+	ErrAccountDeactivated = &Error{Kind: KindPermissionDenied, Reason: "ACCOUNT_DEACTIVATED", Message: "account is deactivated"}
+
+	ErrInvalidCredentials  = &Error{Kind: KindUnauthenticated, Reason: "INVALID_CREDENTIALS", Message: "incorrect email or password"}
+	ErrInvalidRefreshToken = &Error{Kind: KindUnauthenticated, Reason: "INVALID_REFRESH_TOKEN", Message: "please authenticate"}
+	ErrRefreshTokenUnknown = &Error{Kind: KindNotFound, Reason: "REFRESH_TOKEN_NOT_FOUND", Message: "invalid token"}
+	// ErrInvalidToken covers one-time tokens (reset, verification, invite); messages vary per flow
+	ErrInvalidToken = &Error{
+		Kind:       KindValidation,
+		Reason:     "INVALID_TOKEN",
+		Message:    "invalid token",
+		Violations: []FieldViolation{{Field: "token"}},
+	}
+)
+
 type authService struct {
 	userRepo     repository.UserRepository
 	tokenRepo    repository.TokenRepository
+	tx           repository.Transactor
 	tokenService *TokenService
 	emailService EmailService
+	auditService AuditService
+	userEvents   UserEventBroker
 	cfg          *config.Config
 }
 
-func NewAuthService(uRepo repository.UserRepository, tRepo repository.TokenRepository, tService *TokenService, eService EmailService, cfg *config.Config) AuthService {
+func NewAuthService(uRepo repository.UserRepository, tRepo repository.TokenRepository, tx repository.Transactor, tService *TokenService, eService EmailService, aService AuditService, userEvents UserEventBroker, cfg *config.Config) AuthService {
 	return &authService{
 		userRepo:     uRepo,
 		tokenRepo:    tRepo,
+		tx:           tx,
 		tokenService: tService,
 		emailService: eService,
+		auditService: aService,
+		userEvents:   userEvents,
 		cfg:          cfg,
 	}
 }
 
-func (s *authService) Register(name, email, password string) (*models.User, string, string, time.Time, time.Time, error) {
-	if exists, _ := s.userRepo.ExistsByEmail(email); exists {
-		return nil, "", "", time.Time{}, time.Time{}, errors.New("email already taken")
+func (s *authService) Register(ctx context.Context, name, email, password string) (*models.User, string, string, time.Time, time.Time, error) {
+	if err := s.checkRegistrationAllowed(email); err != nil {
+		return nil, "", "", time.Time{}, time.Time{}, err
+	}
+
+	if exists, _ := s.userRepo.ExistsByEmail(ctx, email); exists {
+		return nil, "", "", time.Time{}, time.Time{}, ErrEmailTaken
 	}
 
 	user := &models.User{
@@ -52,63 +88,119 @@ func (s *authService) Register(name, email, password string) (*models.User, stri
 		Role:     "user",
 	}
 
-	if err := s.userRepo.Create(user); err != nil {
+	if err := s.userRepo.Create(ctx, user); err != nil {
 		return nil, "", "", time.Time{}, time.Time{}, err
 	}
+	s.auditService.Record(ctx, AuditEntry{Type: models.AuditRegister, ActorID: user.ID, TargetID: user.ID})
+	s.userEvents.Publish(UserEventCreated, user)
+	metrics.Registrations.WithLabelValues("signup").Inc()
 
-	accessToken, refreshToken, accessExp, refreshExp, err := s.tokenService.GenerateAuthTokens(user)
+	accessToken, refreshToken, accessExp, refreshExp, err := s.tokenService.GenerateAuthTokens(ctx, user)
 	return user, accessToken, refreshToken, accessExp, refreshExp, err
 }
 
-func (s *authService) Login(email, password string) (*models.User, string, string, time.Time, time.Time, error) {
-	user, err := s.userRepo.FindByEmail(email)
+func (s *authService) Login(ctx context.Context, email, password string) (*models.User, string, string, time.Time, time.Time, error) {
+	user, err := s.userRepo.FindByEmail(ctx, email)
+	if isContextError(err) {
+		return nil, "", "", time.Time{}, time.Time{}, err
+	}
 	if err != nil || !user.ComparePassword(password) {
-		return nil, "", "", time.Time{}, time.Time{}, errors.New("incorrect email or password")
+		entry := AuditEntry{
+			Type:    models.AuditLoginFailure,
+			Outcome: models.AuditOutcomeFailure,
+			// Masked: a mistyped password in the email field would otherwise be kept in the log
+			Details: map[string]string{"email": redact.Email(email), "reason": "invalid_credentials"},
+		}
+		if user != nil {
+			entry.TargetID = user.ID
+		}
+		s.auditService.Record(ctx, entry)
+		metrics.Logins.WithLabelValues("invalid_credentials").Inc()
+		return nil, "", "", time.Time{}, time.Time{}, ErrInvalidCredentials
 	}
 
-	accessToken, refreshToken, accessExp, refreshExp, err := s.tokenService.GenerateAuthTokens(user)
+	if err := checkAccountActive(user); err != nil {
+		s.auditService.Record(ctx, AuditEntry{
+			Type:     models.AuditLoginFailure,
+			ActorID:  user.ID,
+			TargetID: user.ID,
+			Outcome:  models.AuditOutcomeFailure,
+			Details:  map[string]string{"reason": user.Status},
+		})
+		metrics.Logins.WithLabelValues(user.Status).Inc()
+		return nil, "", "", time.Time{}, time.Time{}, err
+	}
+
+	accessToken, refreshToken, accessExp, refreshExp, err := s.tokenService.GenerateAuthTokens(ctx, user)
+	if err == nil {
+		s.auditService.Record(ctx, AuditEntry{Type: models.AuditLoginSuccess, ActorID: user.ID, TargetID: user.ID})
+		metrics.Logins.WithLabelValues("success").Inc()
+	}
 	return user, accessToken, refreshToken, accessExp, refreshExp, err
 }
 
-func (s *authService) Logout(refreshToken string) error {
-	tokenDoc, err := s.tokenService.VerifyToken(refreshToken, models.TokenTypeRefresh)
+func (s *authService) Logout(ctx context.Context, refreshToken string) error {
+	tokenDoc, err := s.tokenService.VerifyToken(ctx, refreshToken, models.TokenTypeRefresh)
 	if err != nil {
-		return errors.New("token not found")
+		return ErrRefreshTokenUnknown
 	}
-	return s.tokenRepo.Delete(tokenDoc)
+	if err := s.tokenRepo.Delete(ctx, tokenDoc); err != nil {
+		return err
+	}
+	s.auditService.Record(ctx, AuditEntry{Type: models.AuditLogout, ActorID: tokenDoc.UserID, TargetID: tokenDoc.UserID})
+	return nil
 }
 
-func (s *authService) RefreshAuth(refreshTokenStr string) (string, string, time.Time, time.Time, error) {
+func (s *authService) RefreshAuth(ctx context.Context, refreshTokenStr string) (string, string, time.Time, time.Time, error) {
 	// 1. Verify existence in DB
-	tokenDoc, err := s.tokenService.VerifyToken(refreshTokenStr, models.TokenTypeRefresh)
+	tokenDoc, err := s.tokenService.VerifyToken(ctx, refreshTokenStr, models.TokenTypeRefresh)
 	if err != nil {
-		return "", "", time.Time{}, time.Time{}, errors.New("please authenticate")
+		return "", "", time.Time{}, time.Time{}, ErrInvalidRefreshToken
 	}
 
 	// 2. Validate JWT Signature
 	payload, err := utils.ValidateToken(refreshTokenStr, s.cfg.JWT.Secret)
 	if err != nil {
-		return "", "", time.Time{}, time.Time{}, errors.New("invalid token")
+		return "", "", time.Time{}, time.Time{}, ErrInvalidRefreshToken.withMessage("invalid token")
 	}
 
 	// 3. Get User
-	user, err := s.userRepo.FindByID(payload.UserID)
+	user, err := s.userRepo.FindByID(ctx, payload.UserID)
 	if err != nil {
-		return "", "", time.Time{}, time.Time{}, errors.New("user not found")
+		return "", "", time.Time{}, time.Time{}, ErrInvalidRefreshToken.withMessage("user not found")
+	}
+
+	if err := checkAccountActive(user); err != nil {
+		s.tokenRepo.Delete(ctx, tokenDoc)
+		s.auditService.Record(ctx, AuditEntry{
+			Type:     models.AuditTokenRefresh,
+			ActorID:  user.ID,
+			TargetID: user.ID,
+			Outcome:  models.AuditOutcomeFailure,
+			Details:  map[string]string{"reason": user.Status},
+		})
+		return "", "", time.Time{}, time.Time{}, err
 	}
 
 	// 4. Delete old token (Rotation)
-	s.tokenRepo.Delete(tokenDoc)
+	s.tokenRepo.Delete(ctx, tokenDoc)
 
 	// 5. Generate new pair
-	return s.tokenService.GenerateAuthTokens(user)
+	accessToken, refreshToken, accessExp, refreshExp, err := s.tokenService.GenerateAuthTokens(ctx, user)
+	if err == nil {
+		s.auditService.Record(ctx, AuditEntry{Type: models.AuditTokenRefresh, ActorID: user.ID, TargetID: user.ID})
+	}
+	return accessToken, refreshToken, accessExp, refreshExp, err
 }
 
-func (s *authService) ForgotPassword(email string) error {
-	user, err := s.userRepo.FindByEmail(email)
+func (s *authService) ForgotPassword(ctx context.Context, email string) error {
+	user, err := s.userRepo.FindByEmail(ctx, email)
 	if err != nil {
 		return nil // Return success to prevent email enumeration
 	}
+	if user.IsPendingInvite() {
+		return nil // Invitees set their password by accepting the invite, not by a reset
+	}
 
 	expires := s.cfg.JWT.ResetPasswordExpiration
 	resetToken, _, err := utils.GenerateToken(user.ID, user.Role, models.TokenTypeResetPassword, expires, s.cfg.JWT.Secret)
@@ -116,36 +208,48 @@ func (s *authService) ForgotPassword(email string) error {
 		return err
 	}
 
-	err = s.tokenService.SaveToken(resetToken, user.ID, time.Now().Add(expires), models.TokenTypeResetPassword)
+	err = s.tokenService.SaveToken(ctx, resetToken, user.ID, time.Now().Add(expires), models.TokenTypeResetPassword)
 	if err != nil {
 		return err
 	}
 
-	return s.emailService.SendResetPasswordEmail(user.Email, resetToken)
+	if err := s.emailService.SendResetPasswordEmail(ctx, user.Email, resetToken); err != nil {
+		return err
+	}
+	s.auditService.Record(ctx, AuditEntry{Type: models.AuditPasswordResetRequest, TargetID: user.ID})
+	return nil
 }
 
-func (s *authService) ResetPassword(tokenStr, newPassword string) error {
-	tokenDoc, err := s.tokenService.VerifyToken(tokenStr, models.TokenTypeResetPassword)
+func (s *authService) ResetPassword(ctx context.Context, tokenStr, newPassword string) error {
+	tokenDoc, err := s.tokenService.VerifyToken(ctx, tokenStr, models.TokenTypeResetPassword)
 	if err != nil {
-		return errors.New("password reset failed")
+		s.auditService.Record(ctx, AuditEntry{
+			Type:    models.AuditPasswordReset,
+			Outcome: models.AuditOutcomeFailure,
+			Details: map[string]string{"reason": "invalid_token"},
+		})
+		return ErrInvalidToken.withMessage("password reset failed")
 	}
 
-	user, err := s.userRepo.FindByID(tokenDoc.UserID)
-	if err != nil {
-		return errors.New("invalid user data")
+	user, err := s.userRepo.FindByID(ctx, tokenDoc.UserID)
+	if err != nil || user.IsPendingInvite() {
+		return ErrInvalidToken.withMessage("invalid user data")
 	}
 
 	user.Password = newPassword
-	if err := s.userRepo.Update(user); err != nil {
+	if err := s.userRepo.Update(ctx, user); err != nil {
 		return err
 	}
 
+	s.auditService.Record(ctx, AuditEntry{Type: models.AuditPasswordReset, ActorID: user.ID, TargetID: user.ID})
+	s.userEvents.Publish(UserEventUpdated, user)
+
 	// Consume all reset tokens for this user
-	return s.tokenRepo.DeleteByUserIDAndType(user.ID, models.TokenTypeResetPassword)
+	return s.tokenRepo.DeleteByUserIDAndType(ctx, user.ID, models.TokenTypeResetPassword)
 }
 
-func (s *authService) SendVerificationEmail(userID string) error {
-	user, err := s.userRepo.FindByID(userID)
+func (s *authService) SendVerificationEmail(ctx context.Context, userID string) error {
+	user, err := s.userRepo.FindByID(ctx, userID)
 	if err != nil {
 		return err
 	}
@@ -156,29 +260,178 @@ func (s *authService) SendVerificationEmail(userID string) error {
 		return err
 	}
 
-	err = s.tokenService.SaveToken(verifyToken, user.ID, time.Now().Add(expires), models.TokenTypeVerifyEmail)
+	err = s.tokenService.SaveToken(ctx, verifyToken, user.ID, time.Now().Add(expires), models.TokenTypeVerifyEmail)
 	if err != nil {
 		return err
 	}
 
-	return s.emailService.SendVerificationEmail(user.Email, verifyToken)
+	return s.emailService.SendVerificationEmail(ctx, user.Email, verifyToken)
 }
 
-func (s *authService) VerifyEmail(tokenStr string) error {
-	tokenDoc, err := s.tokenService.VerifyToken(tokenStr, models.TokenTypeVerifyEmail)
+func (s *authService) VerifyEmail(ctx context.Context, tokenStr string) error {
+	tokenDoc, err := s.tokenService.VerifyToken(ctx, tokenStr, models.TokenTypeVerifyEmail)
 	if err != nil {
-		return errors.New("email verification failed")
+		return ErrInvalidToken.withMessage("email verification failed")
 	}
 
-	user, err := s.userRepo.FindByID(tokenDoc.UserID)
+	user, err := s.userRepo.FindByID(ctx, tokenDoc.UserID)
 	if err != nil {
-		return errors.New("email verification failed")
+		return ErrInvalidToken.withMessage("email verification failed")
+	}
+	if err := checkAccountActive(user); err != nil {
+		return err
 	}
 
 	user.IsEmailVerified = true
-	if err := s.userRepo.Update(user); err != nil {
+	if err := s.userRepo.Update(ctx, user); err != nil {
 		return err
 	}
 
-	return s.tokenRepo.DeleteByUserIDAndType(user.ID, models.TokenTypeVerifyEmail)
+	if err := s.tokenRepo.DeleteByUserIDAndType(ctx, user.ID, models.TokenTypeVerifyEmail); err != nil {
+		return err
+	}
+	s.auditService.Record(ctx, AuditEntry{Type: models.AuditEmailVerified, ActorID: user.ID, TargetID: user.ID})
+	s.userEvents.Publish(UserEventUpdated, user)
+	return nil
+}
+
+func (s *authService) InviteUser(ctx context.Context, email, name, role string) (*models.User, error) {
+	if role == "" {
+		role = models.RoleUser
+	}
+	if role != models.RoleUser && role != models.RoleAdmin {
+		return nil, fieldError("role", "invalid role")
+	}
+
+	if exists, _ := s.userRepo.ExistsByEmail(ctx, email); exists {
+		return nil, ErrEmailTaken
+	}
+
+	// 1. Create the placeholder account and its invite token together.
+	// No password until the invite is accepted, so it cannot log in.
+	user := &models.User{
+		Name:  name,
+		Email: email,
+		Role:  role,
+	}
+
+	var inviteToken string
+	err := s.tx.Transaction(ctx, func(tx repository.TxRepositories) error {
+		if err := tx.Users.Create(ctx, user); err != nil {
+			return err
+		}
+
+		expires := s.cfg.JWT.InviteExpiration
+		var err error
+		inviteToken, _, err = utils.GenerateToken(user.ID, user.Role, models.TokenTypeInvite, expires, s.cfg.JWT.Secret)
+		if err != nil {
+			return err
+		}
+		return tx.Tokens.Create(ctx, newToken(inviteToken, user.ID, time.Now().Add(expires), models.TokenTypeInvite))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. Send the invitation. An account nobody was told about can't be claimed,
+	// and would keep its email reserved, so remove it if sending fails.
+	if err := s.emailService.SendInvitationEmail(ctx, user.Email, inviteToken); err != nil {
+		if purgeErr := s.purgeUser(context.WithoutCancel(ctx), user.ID); purgeErr != nil {
+			logger.FromContext(ctx).Error("Failed to remove uninvited user", "user_id", user.ID, "error", purgeErr)
+		}
+		return nil, err
+	}
+
+	// 3. Announce it only once the invite is out
+	s.userEvents.Publish(UserEventCreated, user)
+	s.auditService.Record(ctx, AuditEntry{
+		Type:     models.AuditInviteSent,
+		TargetID: user.ID,
+		Details:  map[string]string{"email": user.Email, "role": user.Role},
+	})
+	return user, nil
+}
+
+func (s *authService) AcceptInvite(ctx context.Context, tokenStr, name, password string) (*models.User, string, string, time.Time, time.Time, error) {
+	tokenDoc, err := s.tokenService.VerifyToken(ctx, tokenStr, models.TokenTypeInvite)
+	if err != nil {
+		return nil, "", "", time.Time{}, time.Time{}, ErrInvalidToken.withMessage("invitation is invalid or expired")
+	}
+
+	// Only a pending invitee can accept; an account that already has a password is claimed
+	user, err := s.userRepo.FindByID(ctx, tokenDoc.UserID)
+	if err != nil || !user.IsPendingInvite() {
+		return nil, "", "", time.Time{}, time.Time{}, ErrInvalidToken.withMessage("invitation is invalid or expired")
+	}
+
+	if name != "" {
+		user.Name = name
+	}
+	if user.Name == "" {
+		return nil, "", "", time.Time{}, time.Time{}, fieldError("name", "name is required")
+	}
+	if password == "" {
+		return nil, "", "", time.Time{}, time.Time{}, fieldError("password", "password is required")
+	}
+
+	// The invite link was delivered to this address, so it counts as verified
+	user.Password = password // Will be hashed by GORM hook
+	user.IsEmailVerified = true
+	if err := s.userRepo.Update(ctx, user); err != nil {
+		return nil, "", "", time.Time{}, time.Time{}, err
+	}
+
+	if err := s.tokenRepo.DeleteByUserIDAndType(ctx, user.ID, models.TokenTypeInvite); err != nil {
+		return nil, "", "", time.Time{}, time.Time{}, err
+	}
+	s.auditService.Record(ctx, AuditEntry{Type: models.AuditInviteAccepted, ActorID: user.ID, TargetID: user.ID})
+	s.userEvents.Publish(UserEventUpdated, user)
+	metrics.Registrations.WithLabelValues("invite").Inc()
+
+	accessToken, refreshToken, accessExp, refreshExp, err := s.tokenService.GenerateAuthTokens(ctx, user)
+	return user, accessToken, refreshToken, accessExp, refreshExp, err
+}
+
+// purgeUser permanently removes a user and its tokens
+func (s *authService) purgeUser(ctx context.Context, id string) error {
+	return s.tx.Transaction(ctx, func(tx repository.TxRepositories) error {
+		if err := tx.Tokens.DeleteByUserID(ctx, id); err != nil {
+			return err
+		}
+		return tx.Users.Purge(ctx, id)
+	})
+}
+
+// checkRegistrationAllowed applies the configured registration mode to open sign-ups
+func (s *authService) checkRegistrationAllowed(email string) error {
+	if s.cfg.Registration.Mode == "invite_only" {
+		return ErrRegistrationClosed
+	}
+
+	if len(s.cfg.Registration.AllowedDomains) == 0 {
+		return nil
+	}
+
+	at := strings.LastIndex(email, "@")
+	if at < 0 {
+		return ErrEmailDomainBlocked
+	}
+	domain := strings.ToLower(email[at+1:])
+	for _, allowed := range s.cfg.Registration.AllowedDomains {
+		if domain == strings.ToLower(allowed) {
+			return nil
+		}
+	}
+	return ErrEmailDomainBlocked
+}
+
+// checkAccountActive rejects suspended and deactivated accounts
+func checkAccountActive(user *models.User) error {
+	switch user.Status {
+	case models.UserStatusSuspended:
+		return ErrAccountSuspended
+	case models.UserStatusDeactivated:
+		return ErrAccountDeactivated
+	}
+	return nil
 }
\ No newline at end of file
@@ -0,0 +1,89 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"starter-kit-grpc-golang/config"
+	"starter-kit-grpc-golang/internal/models"
+	"starter-kit-grpc-golang/internal/repository"
+	"starter-kit-grpc-golang/internal/testutil"
+)
+
+// recordingEmail captures the tokens that would have been mailed
+type recordingEmail struct {
+	resets  []string
+	invites []string
+}
+
+func (e *recordingEmail) SendEmail(ctx context.Context, to, subject, body string) error {
+	return nil
+}
+func (e *recordingEmail) SendVerificationEmail(ctx context.Context, to, token string) error {
+	return nil
+}
+func (e *recordingEmail) SendResetPasswordEmail(ctx context.Context, to, token string) error {
+	e.resets = append(e.resets, token)
+	return nil
+}
+func (e *recordingEmail) SendInvitationEmail(ctx context.Context, to, token string) error {
+	e.invites = append(e.invites, token)
+	return nil
+}
+
+func TestPendingInviteCannotBeClaimedByPasswordReset(t *testing.T) {
+	db := testutil.NewDB(t)
+	cfg := &config.Config{JWT: config.JWTConfig{
+		Secret:                  "jwt-secret",
+		AccessExpiration:        time.Minute,
+		RefreshExpiration:       time.Hour,
+		ResetPasswordExpiration: time.Hour,
+		InviteExpiration:        time.Hour,
+	}}
+	tokens := repository.NewTokenRepository(db)
+	tokenService := NewTokenService(tokens, cfg)
+	email := &recordingEmail{}
+	svc := NewAuthService(repository.NewUserRepository(db), tokens, repository.NewTransactor(db), tokenService, email,
+		NewAuditService(repository.NewAuditRepository(db, testAuditSecret), testAuditSecret), NewUserEventBroker(cfg), cfg)
+
+	ctx := context.Background()
+	user, err := svc.InviteUser(ctx, "invitee@example.com", "Invitee", models.RoleUser)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// 1. No reset link is sent to a pending invitee
+	if err := svc.ForgotPassword(ctx, user.Email); err != nil {
+		t.Fatal(err)
+	}
+	if len(email.resets) != 0 {
+		t.Fatalf("sent %d reset emails to a pending invitee", len(email.resets))
+	}
+
+	// 2. Nor does a reset token issued anyway set the password
+	resetToken := "reset-token"
+	if err := tokenService.SaveToken(ctx, resetToken, user.ID, time.Now().Add(time.Hour), models.TokenTypeResetPassword); err != nil {
+		t.Fatal(err)
+	}
+	if err := svc.ResetPassword(ctx, resetToken, "password1"); err == nil {
+		t.Error("ResetPassword set a pending invitee's password")
+	}
+
+	// 3. The invite claims the account, once
+	if _, _, _, _, _, err := svc.AcceptInvite(ctx, email.invites[0], "", "password1"); err != nil {
+		t.Fatalf("AcceptInvite: %v", err)
+	}
+	inviteToken := "second-invite-token"
+	if err := tokenService.SaveToken(ctx, inviteToken, user.ID, time.Now().Add(time.Hour), models.TokenTypeInvite); err != nil {
+		t.Fatal(err)
+	}
+	if _, _, _, _, _, err := svc.AcceptInvite(ctx, inviteToken, "", "password2"); err == nil {
+		t.Error("AcceptInvite claimed an account that already has a password")
+	}
+
+	// 4. Once claimed, the account can reset its password as usual
+	if err := svc.ForgotPassword(ctx, user.Email); err != nil || len(email.resets) != 1 {
+		t.Errorf("ForgotPassword after accepting: err %v, %d reset emails", err, len(email.resets))
+	}
+}
\ No newline at end of file
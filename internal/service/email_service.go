@@ -1,16 +1,24 @@
 package service
 
 import (
+	"context"
 	"fmt"
 	"net/smtp"
 
 	"starter-kit-grpc-golang/config"
+	"starter-kit-grpc-golang/internal/metrics"
+	"starter-kit-grpc-golang/internal/tracing"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 )
 
 type EmailService interface {
-	SendEmail(to, subject, body string) error
-	SendResetPasswordEmail(to, token string) error
-	SendVerificationEmail(to, token string) error
+	SendEmail(ctx context.Context, to, subject, body string) error
+	SendResetPasswordEmail(ctx context.Context, to, token string) error
+	SendVerificationEmail(ctx context.Context, to, token string) error
+	SendInvitationEmail(ctx context.Context, to, token string) error
 }
 
 type emailService struct {
@@ -21,12 +29,28 @@ func NewEmailService(cfg *config.Config) EmailService {
 	return &emailService{cfg: cfg}
 }
 
-func (s *emailService) SendEmail(to, subject, body string) error {
+func (s *emailService) SendEmail(ctx context.Context, to, subject, body string) error {
+	return s.send(ctx, "generic", to, subject, body)
+}
+
+// send delivers one email, counting it in metrics.EmailsSent under template
+func (s *emailService) send(ctx context.Context, template, to, subject, body string) error {
 	// In test/dev, we might skip actual sending if not configured
 	if s.cfg.SMTP.Host == "" {
-		return nil 
+		metrics.EmailsSent.WithLabelValues(template, "skipped").Inc()
+		return nil
 	}
 
+	_, span := tracing.Tracer().Start(ctx, "smtp.send",
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attribute.String("email.template", template),
+			attribute.String("server.address", s.cfg.SMTP.Host),
+			attribute.Int("server.port", s.cfg.SMTP.Port),
+		),
+	)
+	defer span.End()
+
 	auth := smtp.PlainAuth("", s.cfg.SMTP.Username, s.cfg.SMTP.Password, s.cfg.SMTP.Host)
 
 	msg := []byte(fmt.Sprintf("To: %s\r\n"+
@@ -38,21 +62,36 @@ func (s *emailService) SendEmail(to, subject, body string) error {
 
 	addr := fmt.Sprintf("%s:%d", s.cfg.SMTP.Host, s.cfg.SMTP.Port)
 
-	return smtp.SendMail(addr, auth, s.cfg.SMTP.From, []string{to}, msg)
+	if err := smtp.SendMail(addr, auth, s.cfg.SMTP.From, []string{to}, msg); err != nil {
+		metrics.EmailsSent.WithLabelValues(template, "failed").Inc()
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return err
+	}
+	metrics.EmailsSent.WithLabelValues(template, "sent").Inc()
+	return nil
 }
 
-func (s *emailService) SendResetPasswordEmail(to, token string) error {
+func (s *emailService) SendResetPasswordEmail(ctx context.Context, to, token string) error {
 	subject := "Reset Password"
 	// Ensure this URL points to your Frontend
 	resetURL := fmt.Sprintf("http://localhost:3000/reset-password?token=%s", token)
 	text := fmt.Sprintf("Dear user,\n\nTo reset your password, click on this link: %s\n\nIf you did not request this, please ignore this email.", resetURL)
-	return s.SendEmail(to, subject, text)
+	return s.send(ctx, "reset_password", to, subject, text)
 }
 
-func (s *emailService) SendVerificationEmail(to, token string) error {
+func (s *emailService) SendVerificationEmail(ctx context.Context, to, token string) error {
 	subject := "Email Verification"
 	// Ensure this URL points to your Frontend
 	verifyURL := fmt.Sprintf("http://localhost:3000/verify-email?token=%s", token)
 	text := fmt.Sprintf("Dear user,\n\nTo verify your email, click on this link: %s\n\nIf you did not create an account, please ignore this email.", verifyURL)
-	return s.SendEmail(to, subject, text)
+	return s.send(ctx, "verify_email", to, subject, text)
+}
+
+func (s *emailService) SendInvitationEmail(ctx context.Context, to, token string) error {
+	subject := "You're Invited"
+	// Ensure this URL points to your Frontend
+	inviteURL := fmt.Sprintf("http://localhost:3000/accept-invite?token=%s", token)
+	text := fmt.Sprintf("Dear user,\n\nYou have been invited to create an account. To accept the invitation, click on this link: %s\n\nIf you were not expecting this invitation, please ignore this email.", inviteURL)
+	return s.send(ctx, "invitation", to, subject, text)
 }
\ No newline at end of file
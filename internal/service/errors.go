@@ -0,0 +1,107 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// Kind classifies a domain error. The transport maps each kind to a status code.
+type Kind int
+
+const (
+	KindInternal Kind = iota
+	KindValidation
+	KindNotFound
+	KindAlreadyExists
+	KindConflict // Lost a race with a concurrent write; re-read and retry
+	KindFailedPrecondition
+	KindPermissionDenied
+	KindUnauthenticated
+	KindResourceExhausted
+	KindOutOfRange
+)
+
+// Error is a typed domain error. Errors with the same Reason match under errors.Is,
+// so copies made by wrap/withMessage still match their sentinel.
+type Error struct {
+	Kind       Kind
+	Reason     string // Stable UPPER_SNAKE_CASE identifier for clients
+	Message    string
+	Violations []FieldViolation  // Offending request fields (KindValidation)
+	Metadata   map[string]string // Extra machine-readable context
+	RetryAfter time.Duration     // How long to back off before retrying, if retryable
+	Err        error             // Underlying cause, if any
+}
+
+// FieldViolation points at one invalid request field. An empty Description means the error message.
+type FieldViolation struct {
+	Field       string
+	Description string
+}
+
+func (e *Error) Error() string { return e.Message }
+
+func (e *Error) Unwrap() error { return e.Err }
+
+func (e *Error) Is(target error) bool {
+	t, ok := target.(*Error)
+	return ok && e.Reason != "" && t.Reason == e.Reason
+}
+
+// wrap returns a copy of e that carries err as its cause and message
+func (e *Error) wrap(err error) *Error {
+	c := *e
+	c.Message = err.Error()
+	c.Err = err
+	return &c
+}
+
+// withMessage returns a copy of e with a more specific message
+func (e *Error) withMessage(format string, args ...interface{}) *Error {
+	c := *e
+	c.Message = fmt.Sprintf(format, args...)
+	return &c
+}
+
+// fieldError reports an invalid value for a single request field
+func fieldError(field, message string) *Error {
+	return &Error{
+		Kind:       KindValidation,
+		Reason:     "INVALID_FIELD",
+		Message:    message,
+		Violations: []FieldViolation{{Field: field}},
+	}
+}
+
+// InvalidField is fieldError for checks the transport makes itself, e.g. on update masks
+func InvalidField(field, message string) *Error {
+	return fieldError(field, message)
+}
+
+// isContextError reports whether err comes from a cancelled or expired request context
+func isContextError(err error) bool {
+	return errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded)
+}
+
+// internalError wraps an unexpected failure. The transport logs it and reports a bare
+// Internal error, so the cause doesn't reach clients.
+func internalError(err error) *Error {
+	return &Error{Kind: KindInternal, Reason: "INTERNAL", Message: err.Error(), Err: err}
+}
+
+// lookupError reports a missing record as notFound. A lookup cut short by the request
+// context keeps the context error, and any other failure (e.g. the database is down)
+// is internal, so an outage isn't reported as NOT_FOUND.
+func lookupError(err error, notFound *Error) error {
+	switch {
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return notFound
+	case isContextError(err):
+		return err
+	}
+	return internalError(err)
+}
\ No newline at end of file
@@ -0,0 +1,36 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"gorm.io/gorm"
+)
+
+func TestLookupError(t *testing.T) {
+	outage := errors.New("dial tcp: connection refused")
+
+	tests := []struct {
+		name string
+		err  error
+		want Kind
+	}{
+		{"missing record", fmt.Errorf("find user: %w", gorm.ErrRecordNotFound), KindNotFound},
+		{"database outage", outage, KindInternal},
+	}
+	for _, tt := range tests {
+		var got *Error
+		if !errors.As(lookupError(tt.err, ErrUserNotFound), &got) || got.Kind != tt.want {
+			t.Errorf("%s: got %v, want kind %d", tt.name, got, tt.want)
+		}
+	}
+
+	if err := lookupError(outage, ErrUserNotFound); errors.Is(err, ErrUserNotFound) || !errors.Is(err, outage) {
+		t.Errorf("outage: got %v, want the cause wrapped and not USER_NOT_FOUND", err)
+	}
+	if err := lookupError(context.DeadlineExceeded, ErrUserNotFound); err != context.DeadlineExceeded {
+		t.Errorf("deadline: got %v, want the context error", err)
+	}
+}
\ No newline at end of file
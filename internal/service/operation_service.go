@@ -0,0 +1,240 @@
+package service
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"starter-kit-grpc-golang/config"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrOperationNotFound  = &Error{Kind: KindNotFound, Reason: "OPERATION_NOT_FOUND", Message: "operation not found"}
+	ErrOperationQueueFull = &Error{
+		Kind:       KindResourceExhausted,
+		Reason:     "OPERATION_QUEUE_FULL",
+		Message:    "too many pending operations",
+		RetryAfter: 30 * time.Second,
+	}
+	ErrInvalidOpPageToken = &Error{
+		Kind:       KindValidation,
+		Reason:     "INVALID_PAGE_TOKEN",
+		Message:    "invalid page token",
+		Violations: []FieldViolation{{Field: "page_token"}},
+	}
+)
+
+// Operation is a snapshot of a long-running operation (AIP-151).
+// Metadata and Response hold kind-specific structs, e.g. *ImportProgress and *ImportResult.
+type Operation struct {
+	Name      string // "operations/<uuid>"
+	Kind      string // e.g. "ImportUsers"
+	Done      bool
+	Metadata  interface{}
+	Response  interface{}
+	Err       error // Set when Done and the operation failed or was cancelled
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// OperationFunc does the work of an operation. It reports progress through report,
+// and should return ctx.Err() promptly once ctx is cancelled.
+type OperationFunc func(ctx context.Context, report func(metadata interface{})) (interface{}, error)
+
+type OperationService interface {
+	// Submit queues fn and returns the pending operation. ctx values (actor, client info)
+	// are kept for the worker, but its cancellation is not.
+	Submit(ctx context.Context, kind string, metadata interface{}, fn OperationFunc) (*Operation, error)
+	Get(name string) (*Operation, error)
+	// List returns operations newest first; the page token is an opaque offset
+	List(pageSize int, pageToken string) ([]Operation, string, error)
+	Cancel(name string) error
+
+	// Work processes queued operations until ctx is cancelled
+	Work(ctx context.Context)
+	// PruneDone forgets operations finished before cutoff
+	PruneDone(cutoff time.Time) int
+}
+
+type operationEntry struct {
+	op     Operation
+	ctx    context.Context
+	cancel context.CancelFunc
+	fn     OperationFunc
+}
+
+type operationService struct {
+	mu    sync.Mutex
+	ops   map[string]*operationEntry
+	queue chan *operationEntry
+}
+
+func NewOperationService(cfg *config.Config) OperationService {
+	return &operationService{
+		ops:   make(map[string]*operationEntry),
+		queue: make(chan *operationEntry, cfg.Operations.QueueSize),
+	}
+}
+
+func (s *operationService) Submit(ctx context.Context, kind string, metadata interface{}, fn OperationFunc) (*Operation, error) {
+	now := time.Now()
+	opCtx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+	entry := &operationEntry{
+		op: Operation{
+			Name:      "operations/" + uuid.New().String(),
+			Kind:      kind,
+			Metadata:  metadata,
+			CreatedAt: now,
+			UpdatedAt: now,
+		},
+		ctx:    opCtx,
+		cancel: cancel,
+		fn:     fn,
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	select {
+	case s.queue <- entry:
+	default:
+		cancel()
+		return nil, ErrOperationQueueFull
+	}
+	s.ops[entry.op.Name] = entry
+
+	op := entry.op
+	return &op, nil
+}
+
+func (s *operationService) Get(name string) (*Operation, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	entry, ok := s.ops[name]
+	if !ok {
+		return nil, ErrOperationNotFound
+	}
+	op := entry.op
+	return &op, nil
+}
+
+func (s *operationService) List(pageSize int, pageToken string) ([]Operation, string, error) {
+	offset := 0
+	if pageToken != "" {
+		n, err := strconv.Atoi(pageToken)
+		if err != nil || n < 0 {
+			return nil, "", ErrInvalidOpPageToken
+		}
+		offset = n
+	}
+	if pageSize < 1 || pageSize > 100 {
+		pageSize = 50
+	}
+
+	s.mu.Lock()
+	ops := make([]Operation, 0, len(s.ops))
+	for _, entry := range s.ops {
+		ops = append(ops, entry.op)
+	}
+	s.mu.Unlock()
+
+	sort.Slice(ops, func(i, j int) bool {
+		if !ops[i].CreatedAt.Equal(ops[j].CreatedAt) {
+			return ops[i].CreatedAt.After(ops[j].CreatedAt)
+		}
+		return ops[i].Name < ops[j].Name
+	})
+
+	if offset >= len(ops) {
+		return nil, "", nil
+	}
+	end := offset + pageSize
+	next := ""
+	if end < len(ops) {
+		next = strconv.Itoa(end)
+	} else {
+		end = len(ops)
+	}
+	return ops[offset:end], next, nil
+}
+
+// Cancel stops a pending or running operation. Cancelling a finished one is a no-op.
+func (s *operationService) Cancel(name string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	entry, ok := s.ops[name]
+	if !ok {
+		return ErrOperationNotFound
+	}
+	if !entry.op.Done {
+		entry.cancel()
+	}
+	return nil
+}
+
+func (s *operationService) Work(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case entry := <-s.queue:
+			s.run(entry)
+		}
+	}
+}
+
+func (s *operationService) run(entry *operationEntry) {
+	defer entry.cancel()
+
+	// Cancelled while still queued
+	if err := entry.ctx.Err(); err != nil {
+		s.finish(entry, nil, err)
+		return
+	}
+
+	report := func(metadata interface{}) {
+		s.mu.Lock()
+		entry.op.Metadata = metadata
+		entry.op.UpdatedAt = time.Now()
+		s.mu.Unlock()
+	}
+
+	response, err := entry.fn(entry.ctx, report)
+	if err == nil && entry.ctx.Err() != nil {
+		err = entry.ctx.Err()
+	}
+	s.finish(entry, response, err)
+}
+
+func (s *operationService) finish(entry *operationEntry, response interface{}, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	entry.op.Done = true
+	entry.op.UpdatedAt = time.Now()
+	if err != nil {
+		entry.op.Err = err
+		return
+	}
+	entry.op.Response = response
+}
+
+func (s *operationService) PruneDone(cutoff time.Time) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	pruned := 0
+	for name, entry := range s.ops {
+		if entry.op.Done && entry.op.UpdatedAt.Before(cutoff) {
+			delete(s.ops, name)
+			pruned++
+		}
+	}
+	return pruned
+}
\ No newline at end of file
@@ -1,6 +1,8 @@
 package service
 
 import (
+	"context"
+	"errors"
 	"time"
 
 	"starter-kit-grpc-golang/config"
@@ -9,6 +11,9 @@ import (
 	"starter-kit-grpc-golang/pkg/utils"
 )
 
+// errTokenExpired is returned by VerifyToken for a stored token past its expiry
+var errTokenExpired = errors.New("token expired")
+
 type TokenService struct {
 	repo repository.TokenRepository
 	cfg  *config.Config
@@ -19,12 +24,15 @@ func NewTokenService(repo repository.TokenRepository, cfg *config.Config) *Token
 }
 
 // GenerateAuthTokens creates Access and Refresh tokens
-func (s *TokenService) GenerateAuthTokens(user *models.User) (string, string, time.Time, time.Time, error) {
-	// 1. Generate Access Token
-	accessToken, accessExp, err := utils.GenerateToken(
-		user.ID,
-		user.Role,
-		"access",
+func (s *TokenService) GenerateAuthTokens(ctx context.Context, user *models.User) (string, string, time.Time, time.Time, error) {
+	// 1. Generate Access Token (carries verification state for EmailVerificationInterceptor)
+	accessToken, accessExp, err := utils.GenerateTokenWithPayload(
+		&utils.TokenPayload{
+			UserID:        user.ID,
+			Role:          user.Role,
+			Type:          "access",
+			EmailVerified: user.IsEmailVerified,
+		},
 		s.cfg.JWT.AccessExpiration,
 		s.cfg.JWT.Secret,
 	)
@@ -45,7 +53,7 @@ func (s *TokenService) GenerateAuthTokens(user *models.User) (string, string, ti
 	}
 
 	// 3. Save Refresh Token to DB
-	err = s.SaveToken(refreshToken, user.ID, refreshExp, models.TokenTypeRefresh)
+	err = s.SaveToken(ctx, refreshToken, user.ID, refreshExp, models.TokenTypeRefresh)
 	if err != nil {
 		return "", "", time.Time{}, time.Time{}, err
 	}
@@ -53,16 +61,27 @@ func (s *TokenService) GenerateAuthTokens(user *models.User) (string, string, ti
 	return accessToken, refreshToken, accessExp, refreshExp, nil
 }
 
-func (s *TokenService) SaveToken(token, userID string, expires time.Time, tokenType string) error {
-	tokenModel := &models.Token{
+func (s *TokenService) SaveToken(ctx context.Context, token, userID string, expires time.Time, tokenType string) error {
+	return s.repo.Create(ctx, newToken(token, userID, expires, tokenType))
+}
+
+func newToken(token, userID string, expires time.Time, tokenType string) *models.Token {
+	return &models.Token{
 		Token:   token,
 		UserID:  userID,
 		Expires: expires,
 		Type:    tokenType,
 	}
-	return s.repo.Create(tokenModel)
 }
 
-func (s *TokenService) VerifyToken(token string, tokenType string) (*models.Token, error) {
-	return s.repo.FindByToken(token, tokenType)
+// VerifyToken returns the stored token if it exists, isn't blacklisted and hasn't expired
+func (s *TokenService) VerifyToken(ctx context.Context, token string, tokenType string) (*models.Token, error) {
+	tokenDoc, err := s.repo.FindByToken(ctx, token, tokenType)
+	if err != nil {
+		return nil, err
+	}
+	if tokenDoc.Expires.Before(time.Now()) {
+		return nil, errTokenExpired
+	}
+	return tokenDoc, nil
 }
\ No newline at end of file
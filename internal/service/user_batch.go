@@ -0,0 +1,157 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"starter-kit-grpc-golang/internal/models"
+	"starter-kit-grpc-golang/internal/repository"
+)
+
+var (
+	ErrUserNotFound  = &Error{Kind: KindNotFound, Reason: "USER_NOT_FOUND", Message: "user not found"}
+	ErrEmptyBatch    = &Error{Kind: KindValidation, Reason: "EMPTY_BATCH", Message: "batch is empty"}
+	ErrBatchTooLarge = &Error{Kind: KindValidation, Reason: "BATCH_TOO_LARGE", Message: "batch is too large"}
+)
+
+// CreateUserDTO is one user to create
+type CreateUserDTO struct {
+	Name     string
+	Email    string
+	Password string
+	Role     string
+}
+
+// BatchResult is the outcome of one batch item; Err is nil on success
+type BatchResult struct {
+	User *models.User
+	Err  error
+}
+
+// BatchItemError fails an all-or-nothing batch, pointing at the item that failed
+type BatchItemError struct {
+	Index int
+	Err   error
+}
+
+func (e *BatchItemError) Error() string {
+	return fmt.Sprintf("item %d: %v", e.Index, e.Err)
+}
+
+func (e *BatchItemError) Unwrap() error { return e.Err }
+
+func (s *userService) checkBatchSize(n int) error {
+	if n == 0 {
+		return ErrEmptyBatch
+	}
+	if n > s.cfg.MaxBatchSize {
+		return fmt.Errorf("%w: %d items, the limit is %d", ErrBatchTooLarge, n, s.cfg.MaxBatchSize)
+	}
+	return nil
+}
+
+// runBatch applies fn to every item inside one transaction. All-or-nothing batches
+// roll back on the first failure; partial batches wrap each item in a savepoint so
+// a failure only undoes that item.
+func (s *userService) runBatch(ctx context.Context, n int, allowPartial bool, fn func(tx repository.TxRepositories, i int) (*models.User, error)) ([]BatchResult, error) {
+	if err := s.checkBatchSize(n); err != nil {
+		return nil, err
+	}
+
+	results := make([]BatchResult, n)
+	err := s.tx.Transaction(ctx, func(tx repository.TxRepositories) error {
+		for i := 0; i < n; i++ {
+			if !allowPartial {
+				user, err := fn(tx, i)
+				if err != nil {
+					return &BatchItemError{Index: i, Err: err}
+				}
+				results[i].User = user
+				continue
+			}
+
+			err := tx.Tx.Transaction(ctx, func(item repository.TxRepositories) error {
+				user, err := fn(item, i)
+				results[i].User = user
+				return err
+			})
+			if err != nil {
+				results[i] = BatchResult{Err: err}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+func (s *userService) BatchGetUsers(ctx context.Context, ids []string, allowPartial bool) ([]BatchResult, error) {
+	if err := s.checkBatchSize(len(ids)); err != nil {
+		return nil, err
+	}
+
+	// A single query needs no explicit transaction to be consistent
+	users, err := s.repo.FindByIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[string]*models.User, len(users))
+	for i := range users {
+		byID[users[i].ID] = &users[i]
+	}
+
+	results := make([]BatchResult, len(ids))
+	for i, id := range ids {
+		user, ok := byID[id]
+		if !ok {
+			if !allowPartial {
+				return nil, &BatchItemError{Index: i, Err: ErrUserNotFound}
+			}
+			results[i].Err = ErrUserNotFound
+			continue
+		}
+		results[i].User = user
+	}
+	return results, nil
+}
+
+func (s *userService) BatchCreateUsers(ctx context.Context, items []CreateUserDTO, allowPartial bool) ([]BatchResult, error) {
+	results, err := s.runBatch(ctx, len(items), allowPartial, func(tx repository.TxRepositories, i int) (*models.User, error) {
+		return createUser(ctx, tx.Users, items[i])
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Audit only what was committed
+	for _, result := range results {
+		if result.Err == nil {
+			s.auditService.Record(ctx, AuditEntry{
+				Type:     models.AuditUserCreated,
+				TargetID: result.User.ID,
+				Details:  map[string]string{"email": result.User.Email, "role": result.User.Role},
+			})
+			s.userEvents.Publish(UserEventCreated, result.User)
+		}
+	}
+	return results, nil
+}
+
+func (s *userService) BatchDeleteUsers(ctx context.Context, ids []string, allowPartial bool) ([]BatchResult, error) {
+	results, err := s.runBatch(ctx, len(ids), allowPartial, func(tx repository.TxRepositories, i int) (*models.User, error) {
+		return nil, deleteUser(ctx, tx.Users, tx.Tokens, ids[i], 0)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for i, result := range results {
+		if result.Err == nil {
+			s.auditService.Record(ctx, AuditEntry{Type: models.AuditUserDeleted, TargetID: ids[i]})
+			s.userEvents.Publish(UserEventDeleted, &models.User{ID: ids[i]})
+		}
+	}
+	return results, nil
+}
\ No newline at end of file
@@ -0,0 +1,449 @@
+package service
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/mail"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"starter-kit-grpc-golang/internal/models"
+)
+
+const (
+	OperationImportUsers = "ImportUsers"
+	OperationExportUsers = "ExportUsers"
+
+	maxImportRows   = 10000
+	maxExportRows   = 10000 // Exports are held in memory until fetched
+	exportBatchSize = 100
+
+	// Field limits of CreateUserRequest and InviteUserRequest (see user.proto),
+	// checked here for import rows
+	maxNameLength     = 100 // characters
+	maxEmailLength    = 254 // characters
+	minPasswordLength = 8   // characters
+	maxPasswordBytes  = 72  // bcrypt ignores anything longer
+)
+
+var (
+	ErrInvalidFormat = &Error{
+		Kind:       KindValidation,
+		Reason:     "INVALID_FORMAT",
+		Message:    `format must be "csv" or "ndjson"`,
+		Violations: []FieldViolation{{Field: "format"}},
+	}
+	ErrInvalidImport = &Error{
+		Kind:       KindValidation,
+		Reason:     "INVALID_IMPORT",
+		Message:    "invalid import file",
+		Violations: []FieldViolation{{Field: "content"}},
+	}
+	ErrExportTooLarge = &Error{
+		Kind:       KindFailedPrecondition,
+		Reason:     "EXPORT_TOO_LARGE",
+		Message:    "too many users to export",
+		Violations: []FieldViolation{{Field: "filter"}},
+	}
+)
+
+type UserBulkService interface {
+	// ImportUsers validates the file and queues an operation that creates (or invites) each row
+	ImportUsers(ctx context.Context, req ImportUsersDTO) (*Operation, error)
+	// ExportUsers queues an operation that renders every user matching the filter
+	ExportUsers(ctx context.Context, req ExportUsersDTO) (*Operation, error)
+}
+
+type userBulkService struct {
+	userService UserService
+	authService AuthService
+	operations  OperationService
+}
+
+type ImportUsersDTO struct {
+	Format      string // "csv" or "ndjson"
+	Content     []byte
+	SendInvites bool // Invite rows without a password instead of failing them
+}
+
+type ExportUsersDTO struct {
+	Format string // "csv" or "ndjson"
+	Filter string // AIP-160, as in ListUsers
+}
+
+// ImportProgress is the metadata of an import operation
+type ImportProgress struct {
+	TotalRows     int
+	ProcessedRows int
+}
+
+// ImportResult is the response of a finished import operation
+type ImportResult struct {
+	Created int
+	Invited int
+	Errors  []ImportRowError
+}
+
+// ImportRowError reports a skipped row; rows are numbered from 1, excluding the CSV header
+type ImportRowError struct {
+	Row     int
+	Email   string
+	Message string
+}
+
+// ExportProgress is the metadata of an export operation
+type ExportProgress struct {
+	ExportedRows int
+}
+
+// ExportResult is the response of a finished export operation
+type ExportResult struct {
+	Format  string
+	Count   int
+	Content []byte
+}
+
+// importRow is one parsed row; Err is set when the row could not be read at all
+type importRow struct {
+	Name     string `json:"name"`
+	Email    string `json:"email"`
+	Role     string `json:"role"`
+	Password string `json:"password"`
+	Err      error  `json:"-"`
+}
+
+func NewUserBulkService(userService UserService, authService AuthService, operations OperationService) UserBulkService {
+	return &userBulkService{userService: userService, authService: authService, operations: operations}
+}
+
+func (s *userBulkService) ImportUsers(ctx context.Context, req ImportUsersDTO) (*Operation, error) {
+	// 1. Parse up front, so unreadable files fail the call instead of the operation
+	rows, err := parseImportRows(req.Format, req.Content)
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. Queue the row-by-row import
+	progress := &ImportProgress{TotalRows: len(rows)}
+	return s.operations.Submit(ctx, OperationImportUsers, progress, func(ctx context.Context, report func(interface{})) (interface{}, error) {
+		return s.importRows(ctx, rows, req.SendInvites, report)
+	})
+}
+
+func (s *userBulkService) importRows(ctx context.Context, rows []importRow, sendInvites bool, report func(interface{})) (interface{}, error) {
+	result := &ImportResult{}
+	seen := make(map[string]int, len(rows)) // Lowercased email -> first row
+
+	for i, row := range rows {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		number := i + 1
+
+		if err := s.importRow(ctx, row, number, seen, sendInvites, result); err != nil {
+			result.Errors = append(result.Errors, ImportRowError{Row: number, Email: row.Email, Message: err.Error()})
+		}
+		report(&ImportProgress{TotalRows: len(rows), ProcessedRows: number})
+	}
+	return result, nil
+}
+
+func (s *userBulkService) importRow(ctx context.Context, row importRow, number int, seen map[string]int, sendInvites bool, result *ImportResult) error {
+	// 1. Validate against the limits of the request the row replaces (CreateUser or
+	// InviteUser), since rows don't pass through the validation interceptor
+	if row.Err != nil {
+		return row.Err
+	}
+	if row.Name == "" {
+		return errors.New("name is required")
+	}
+	if row.Role == "" {
+		row.Role = models.RoleUser
+	}
+	if row.Password == "" && !sendInvites {
+		return errors.New("password is required unless send_invites is set")
+	}
+	if err := validateImportRow(row); err != nil {
+		return err
+	}
+
+	// 2. Dedup within the file (existing users are caught on create)
+	key := strings.ToLower(row.Email)
+	if first, ok := seen[key]; ok {
+		return fmt.Errorf("duplicate email, first seen on row %d", first)
+	}
+	seen[key] = number
+
+	// 3. Create, or invite when there is no password
+	if row.Password == "" {
+		if _, err := s.authService.InviteUser(ctx, row.Email, row.Name, row.Role); err != nil {
+			return err
+		}
+		result.Invited++
+		return nil
+	}
+	if _, err := s.userService.CreateUser(ctx, row.Name, row.Email, row.Password, row.Role); err != nil {
+		return err
+	}
+	result.Created++
+	return nil
+}
+
+func validateImportRow(row importRow) error {
+	var problems []string
+	if utf8.RuneCountInString(row.Name) > maxNameLength {
+		problems = append(problems, fmt.Sprintf("name must be at most %d characters", maxNameLength))
+	}
+	switch {
+	case row.Email == "":
+		problems = append(problems, "email is required")
+	case utf8.RuneCountInString(row.Email) > maxEmailLength:
+		problems = append(problems, fmt.Sprintf("email must be at most %d characters", maxEmailLength))
+	case !isEmail(row.Email):
+		problems = append(problems, "email must be a valid email address")
+	}
+	if row.Password != "" {
+		if utf8.RuneCountInString(row.Password) < minPasswordLength {
+			problems = append(problems, fmt.Sprintf("password must be at least %d characters", minPasswordLength))
+		}
+		if len(row.Password) > maxPasswordBytes {
+			problems = append(problems, fmt.Sprintf("password must be at most %d bytes", maxPasswordBytes))
+		}
+	}
+	if row.Role != models.RoleUser && row.Role != models.RoleAdmin {
+		problems = append(problems, "role must be one of: user, admin")
+	}
+
+	if len(problems) == 0 {
+		return nil
+	}
+	return errors.New(strings.Join(problems, "; "))
+}
+
+// isEmail accepts a bare address ("a@example.com"), not a display name form
+func isEmail(s string) bool {
+	addr, err := mail.ParseAddress(s)
+	return err == nil && addr.Address == s
+}
+
+func parseImportRows(format string, content []byte) ([]importRow, error) {
+	var rows []importRow
+	var err error
+	switch format {
+	case "csv":
+		rows, err = parseCSVRows(content)
+	case "ndjson":
+		rows, err = parseNDJSONRows(content)
+	default:
+		return nil, ErrInvalidFormat
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if len(rows) == 0 {
+		return nil, fmt.Errorf("%w: no rows", ErrInvalidImport)
+	}
+	if len(rows) > maxImportRows {
+		return nil, fmt.Errorf("%w: %d rows, the limit is %d", ErrInvalidImport, len(rows), maxImportRows)
+	}
+	return rows, nil
+}
+
+func parseCSVRows(content []byte) ([]importRow, error) {
+	reader := csv.NewReader(bytes.NewReader(content))
+	reader.FieldsPerRecord = -1 // Checked per row, so one bad row doesn't fail the file
+	reader.TrimLeadingSpace = true
+
+	// 1. Header
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("%w: missing CSV header", ErrInvalidImport)
+	}
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		name = strings.ToLower(strings.TrimSpace(name))
+		switch name {
+		case "name", "email", "role", "password":
+			columns[name] = i
+		default:
+			return nil, fmt.Errorf("%w: unknown CSV column %q", ErrInvalidImport, name)
+		}
+	}
+	if _, ok := columns["name"]; !ok {
+		return nil, fmt.Errorf("%w: CSV header needs a name column", ErrInvalidImport)
+	}
+	if _, ok := columns["email"]; !ok {
+		return nil, fmt.Errorf("%w: CSV header needs an email column", ErrInvalidImport)
+	}
+
+	// 2. Rows
+	field := func(record []string, name string) string {
+		if i, ok := columns[name]; ok {
+			return strings.TrimSpace(record[i])
+		}
+		return ""
+	}
+
+	var rows []importRow
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidImport, err)
+		}
+		if len(record) != len(header) {
+			rows = append(rows, importRow{Err: fmt.Errorf("expected %d fields, found %d", len(header), len(record))})
+			continue
+		}
+		rows = append(rows, importRow{
+			Name:     field(record, "name"),
+			Email:    field(record, "email"),
+			Role:     field(record, "role"),
+			Password: field(record, "password"),
+		})
+	}
+	return rows, nil
+}
+
+func parseNDJSONRows(content []byte) ([]importRow, error) {
+	var rows []importRow
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+
+	for scanner.Scan() {
+		line := bytes.TrimSpace(scanner.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+
+		var row importRow
+		decoder := json.NewDecoder(bytes.NewReader(line))
+		decoder.DisallowUnknownFields()
+		if err := decoder.Decode(&row); err != nil {
+			row = importRow{Err: fmt.Errorf("invalid JSON: %v", err)}
+		}
+		row.Name = strings.TrimSpace(row.Name)
+		row.Email = strings.TrimSpace(row.Email)
+		row.Role = strings.TrimSpace(row.Role)
+		rows = append(rows, row)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidImport, err)
+	}
+	return rows, nil
+}
+
+func (s *userBulkService) ExportUsers(ctx context.Context, req ExportUsersDTO) (*Operation, error) {
+	if req.Format != "csv" && req.Format != "ndjson" {
+		return nil, ErrInvalidFormat
+	}
+	// Validate the filter and size now; the operation re-parses the filter through GetUsers
+	page, err := s.userService.GetUsers(ctx, UserQuery{Filter: req.Filter}, PageParams{Limit: 1, IncludeTotal: true})
+	if err != nil {
+		return nil, err
+	}
+	if page.Total > maxExportRows {
+		return nil, ErrExportTooLarge.withMessage("%d users match, the limit is %d; narrow the filter", page.Total, maxExportRows)
+	}
+
+	return s.operations.Submit(ctx, OperationExportUsers, &ExportProgress{}, func(ctx context.Context, report func(interface{})) (interface{}, error) {
+		return s.exportUsers(ctx, req, report)
+	})
+}
+
+func (s *userBulkService) exportUsers(ctx context.Context, req ExportUsersDTO, report func(interface{})) (interface{}, error) {
+	var buf bytes.Buffer
+	writer := newUserExportWriter(req.Format, &buf)
+
+	count := 0
+	params := PageParams{Limit: exportBatchSize}
+	for {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		page, err := s.userService.GetUsers(ctx, UserQuery{Filter: req.Filter}, params)
+		if err != nil {
+			return nil, err
+		}
+		for i := range page.Users {
+			if err := writer.write(&page.Users[i]); err != nil {
+				return nil, err
+			}
+		}
+		count += len(page.Users)
+		if count > maxExportRows {
+			// Users were added since the export was queued
+			return nil, ErrExportTooLarge.withMessage("more than %d users match; narrow the filter", maxExportRows)
+		}
+		report(&ExportProgress{ExportedRows: count})
+
+		if page.NextPageToken == "" {
+			break
+		}
+		params.PageToken = page.NextPageToken
+	}
+
+	if err := writer.flush(); err != nil {
+		return nil, err
+	}
+	return &ExportResult{Format: req.Format, Count: count, Content: buf.Bytes()}, nil
+}
+
+// userExportWriter renders users as CSV (with a header) or NDJSON. Passwords are never exported.
+type userExportWriter struct {
+	csv  *csv.Writer
+	json *json.Encoder
+}
+
+var userExportColumns = []string{"id", "name", "email", "role", "status", "is_email_verified", "created_at"}
+
+func newUserExportWriter(format string, w io.Writer) *userExportWriter {
+	if format == "ndjson" {
+		return &userExportWriter{json: json.NewEncoder(w)}
+	}
+	writer := &userExportWriter{csv: csv.NewWriter(w)}
+	_ = writer.csv.Write(userExportColumns)
+	return writer
+}
+
+func (w *userExportWriter) write(u *models.User) error {
+	values := []string{
+		u.ID,
+		u.Name,
+		u.Email,
+		u.Role,
+		u.Status,
+		strconv.FormatBool(u.IsEmailVerified),
+		u.CreatedAt.UTC().Format(time.RFC3339),
+	}
+	if w.csv != nil {
+		return w.csv.Write(values)
+	}
+
+	record := make(map[string]interface{}, len(values))
+	for i, column := range userExportColumns {
+		record[column] = values[i]
+	}
+	record["is_email_verified"] = u.IsEmailVerified
+	return w.json.Encode(record)
+}
+
+func (w *userExportWriter) flush() error {
+	if w.csv != nil {
+		w.csv.Flush()
+		return w.csv.Error()
+	}
+	return nil
+}
\ No newline at end of file
@@ -0,0 +1,33 @@
+package service
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestValidateImportRow(t *testing.T) {
+	tests := []struct {
+		name string
+		row  importRow
+		want string // substring of the error, "" for a valid row
+	}{
+		{"valid create", importRow{Name: "Ann", Email: "ann@example.com", Password: "password1", Role: "user"}, ""},
+		{"valid invite", importRow{Name: "Ann", Email: "ann@example.com", Role: "admin"}, ""},
+		{"long name", importRow{Name: strings.Repeat("n", 101), Email: "ann@example.com", Role: "user"}, "name must be at most 100"},
+		{"missing email", importRow{Name: "Ann", Role: "user"}, "email is required"},
+		{"display name email", importRow{Name: "Ann", Email: "Ann <ann@example.com>", Role: "user"}, "valid email"},
+		{"long email", importRow{Name: "Ann", Email: strings.Repeat("a", 250) + "@example.com", Role: "user"}, "email must be at most 254"},
+		{"short password", importRow{Name: "Ann", Email: "ann@example.com", Password: "short", Role: "user"}, "at least 8"},
+		{"long password", importRow{Name: "Ann", Email: "ann@example.com", Password: strings.Repeat("é", 40), Role: "user"}, "at most 72 bytes"},
+		{"unknown role", importRow{Name: "Ann", Email: "ann@example.com", Role: "owner"}, "role must be one of"},
+	}
+	for _, tt := range tests {
+		err := validateImportRow(tt.row)
+		switch {
+		case tt.want == "" && err != nil:
+			t.Errorf("%s: unexpected error %v", tt.name, err)
+		case tt.want != "" && (err == nil || !strings.Contains(err.Error(), tt.want)):
+			t.Errorf("%s: got %v, want an error containing %q", tt.name, err, tt.want)
+		}
+	}
+}
\ No newline at end of file
@@ -0,0 +1,217 @@
+package service
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"starter-kit-grpc-golang/config"
+	"starter-kit-grpc-golang/internal/models"
+)
+
+const (
+	UserEventCreated = "created"
+	UserEventUpdated = "updated"
+	UserEventDeleted = "deleted"
+)
+
+var (
+	ErrInvalidResumeToken = &Error{
+		Kind:       KindValidation,
+		Reason:     "INVALID_RESUME_TOKEN",
+		Message:    "invalid resume token",
+		Violations: []FieldViolation{{Field: "resume_token"}},
+	}
+	// ErrResumeTokenExpired means events after the token are no longer buffered
+	// (or the server restarted); clients should re-list and watch from now
+	ErrResumeTokenExpired = &Error{
+		Kind:    KindOutOfRange,
+		Reason:  "RESUME_TOKEN_EXPIRED",
+		Message: "resume token expired, list users again and watch without a token",
+	}
+	// ErrSubscriberTooSlow ends a watch whose consumer fell behind; it can resume from its last token
+	ErrSubscriberTooSlow = &Error{
+		Kind:    KindResourceExhausted,
+		Reason:  "WATCHER_TOO_SLOW",
+		Message: "watcher fell too far behind, resume from the last token",
+	}
+)
+
+// UserEvent is one change in the user feed. Deleted events only carry the user ID.
+type UserEvent struct {
+	Seq        uint64
+	Type       string
+	User       models.User
+	OccurredAt time.Time
+}
+
+// UserEventBroker fans user changes out to watchers. It keeps a bounded history
+// so watchers can resume after a reconnect, and never blocks publishers: a watcher
+// whose buffer fills up is dropped with ErrSubscriberTooSlow.
+type UserEventBroker interface {
+	Publish(eventType string, user *models.User)
+	// Watch calls fn for every event after resumeToken ("" means from now) until ctx
+	// is done or fn fails
+	Watch(ctx context.Context, resumeToken string, fn func(event UserEvent) error) error
+	// ResumeToken identifies the position right after event
+	ResumeToken(event UserEvent) string
+}
+
+type userEventSubscriber struct {
+	events chan UserEvent
+	lagged chan struct{} // Closed when the subscriber is dropped for being slow
+}
+
+type userEventBroker struct {
+	mu          sync.Mutex
+	epoch       string // Distinguishes tokens issued before a restart
+	seq         uint64
+	history     []UserEvent // Ring of the last cap(history) events, oldest first
+	historySize int
+	bufferSize  int
+	subscribers map[*userEventSubscriber]struct{}
+}
+
+func NewUserEventBroker(cfg *config.Config) UserEventBroker {
+	return &userEventBroker{
+		epoch:       strconv.FormatInt(time.Now().UnixNano(), 36),
+		historySize: max(cfg.Watch.HistorySize, 1),
+		bufferSize:  max(cfg.Watch.SubscriberBuffer, 1),
+		subscribers: make(map[*userEventSubscriber]struct{}),
+	}
+}
+
+func (b *userEventBroker) Publish(eventType string, user *models.User) {
+	event := UserEvent{Type: eventType, User: *user, OccurredAt: time.Now()}
+	if eventType == UserEventDeleted {
+		event.User = models.User{ID: user.ID}
+	}
+	event.User.Password = ""
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.seq++
+	event.Seq = b.seq
+
+	b.history = append(b.history, event)
+	if len(b.history) > b.historySize {
+		b.history = b.history[len(b.history)-b.historySize:]
+	}
+
+	for sub := range b.subscribers {
+		select {
+		case sub.events <- event:
+		default:
+			// Backpressure: drop the slow watcher rather than block the write path
+			delete(b.subscribers, sub)
+			close(sub.lagged)
+		}
+	}
+}
+
+func (b *userEventBroker) Watch(ctx context.Context, resumeToken string, fn func(event UserEvent) error) error {
+	backlog, sub, err := b.subscribe(resumeToken)
+	if err != nil {
+		return err
+	}
+	defer b.unsubscribe(sub)
+
+	// 1. Replay what was missed since the token
+	for _, event := range backlog {
+		if err := fn(event); err != nil {
+			return err
+		}
+	}
+
+	// 2. Follow live events
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case event := <-sub.events:
+			if err := fn(event); err != nil {
+				return err
+			}
+		case <-sub.lagged:
+			// Deliver what was buffered before giving up, so the last token stays contiguous
+			for {
+				select {
+				case event := <-sub.events:
+					if err := fn(event); err != nil {
+						return err
+					}
+				default:
+					return ErrSubscriberTooSlow
+				}
+			}
+		}
+	}
+}
+
+// subscribe registers a subscriber and returns the buffered events after the token,
+// atomically, so nothing falls between the backlog and the live feed
+func (b *userEventBroker) subscribe(resumeToken string) ([]UserEvent, *userEventSubscriber, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	var backlog []UserEvent
+	if resumeToken != "" {
+		after, err := b.parseResumeToken(resumeToken)
+		if err != nil {
+			return nil, nil, err
+		}
+		if after > b.seq {
+			return nil, nil, ErrInvalidResumeToken
+		}
+		// The next event must still be buffered (or not have happened yet)
+		if after < b.seq && (len(b.history) == 0 || b.history[0].Seq > after+1) {
+			return nil, nil, ErrResumeTokenExpired
+		}
+		for _, event := range b.history {
+			if event.Seq > after {
+				backlog = append(backlog, event)
+			}
+		}
+	}
+
+	sub := &userEventSubscriber{
+		events: make(chan UserEvent, b.bufferSize),
+		lagged: make(chan struct{}),
+	}
+	b.subscribers[sub] = struct{}{}
+	return backlog, sub, nil
+}
+
+func (b *userEventBroker) unsubscribe(sub *userEventSubscriber) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	delete(b.subscribers, sub)
+}
+
+func (b *userEventBroker) ResumeToken(event UserEvent) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(fmt.Sprintf("%s.%d", b.epoch, event.Seq)))
+}
+
+func (b *userEventBroker) parseResumeToken(token string) (uint64, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return 0, ErrInvalidResumeToken
+	}
+	epoch, seq, ok := strings.Cut(string(raw), ".")
+	if !ok {
+		return 0, ErrInvalidResumeToken
+	}
+	after, err := strconv.ParseUint(seq, 10, 64)
+	if err != nil {
+		return 0, ErrInvalidResumeToken
+	}
+	if epoch != b.epoch {
+		return 0, ErrResumeTokenExpired
+	}
+	return after, nil
+}
\ No newline at end of file
@@ -0,0 +1,217 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"starter-kit-grpc-golang/config"
+	"starter-kit-grpc-golang/internal/models"
+)
+
+func newTestBroker(historySize, bufferSize int) *userEventBroker {
+	cfg := &config.Config{Watch: config.WatchConfig{HistorySize: historySize, SubscriberBuffer: bufferSize}}
+	return NewUserEventBroker(cfg).(*userEventBroker)
+}
+
+func publishUsers(b *userEventBroker, n int) {
+	for i := 0; i < n; i++ {
+		b.Publish(UserEventUpdated, &models.User{ID: fmt.Sprintf("user-%d", i), Password: "hash"})
+	}
+}
+
+// waitForSubscribers waits until n watchers are registered, so events published
+// afterwards reach them live
+func waitForSubscribers(t *testing.T, b *userEventBroker, n int) {
+	t.Helper()
+	for deadline := time.Now().Add(5 * time.Second); time.Now().Before(deadline); time.Sleep(time.Millisecond) {
+		b.mu.Lock()
+		count := len(b.subscribers)
+		b.mu.Unlock()
+		if count == n {
+			return
+		}
+	}
+	t.Fatalf("timed out waiting for %d subscribers", n)
+}
+
+// collect watches from token until want events arrived and returns their sequence numbers
+func collect(t *testing.T, b *userEventBroker, token string, want int) ([]uint64, error) {
+	t.Helper()
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	var seqs []uint64
+	done := errors.New("done")
+	err := b.Watch(ctx, token, func(event UserEvent) error {
+		if event.User.Password != "" {
+			t.Errorf("event %d carries the password hash", event.Seq)
+		}
+		seqs = append(seqs, event.Seq)
+		if len(seqs) == want {
+			return done
+		}
+		return nil
+	})
+	if errors.Is(err, done) {
+		err = nil
+	}
+	return seqs, err
+}
+
+func tokenFor(b *userEventBroker, seq uint64) string {
+	return b.ResumeToken(UserEvent{Seq: seq})
+}
+
+func TestWatchLiveAndResume(t *testing.T) {
+	b := newTestBroker(10, 10)
+
+	result := make(chan []uint64)
+	go func() {
+		seqs, err := collect(t, b, "", 3)
+		if err != nil {
+			t.Error(err)
+		}
+		result <- seqs
+	}()
+	waitForSubscribers(t, b, 1)
+	publishUsers(b, 3)
+	if got := <-result; fmt.Sprint(got) != "[1 2 3]" {
+		t.Errorf("live events %v, want [1 2 3]", got)
+	}
+
+	// A reconnecting watcher replays what it missed, then follows live events
+	result2 := make(chan []uint64)
+	go func() {
+		seqs, err := collect(t, b, tokenFor(b, 1), 3)
+		if err != nil {
+			t.Error(err)
+		}
+		result2 <- seqs
+	}()
+	waitForSubscribers(t, b, 1)
+	publishUsers(b, 1)
+	if got := <-result2; fmt.Sprint(got) != "[2 3 4]" {
+		t.Errorf("resumed events %v, want [2 3 4]", got)
+	}
+}
+
+func TestWatchResumeTokens(t *testing.T) {
+	b := newTestBroker(2, 10)
+	publishUsers(b, 5) // History keeps 4 and 5
+
+	other := newTestBroker(2, 10)
+	other.epoch = "restarted"
+
+	tests := []struct {
+		name  string
+		token string
+		err   error
+		want  string
+	}{
+		{"next event buffered", tokenFor(b, 3), nil, "[4 5]"},
+		{"last event", tokenFor(b, 5), nil, "[]"},
+		{"next event evicted", tokenFor(b, 2), ErrResumeTokenExpired, ""},
+		{"issued before a restart", other.ResumeToken(UserEvent{Seq: 4}), ErrResumeTokenExpired, ""},
+		{"from the future", tokenFor(b, 6), ErrInvalidResumeToken, ""},
+		{"not base64", "%%%", ErrInvalidResumeToken, ""},
+		{"malformed", "bm9wZQ", ErrInvalidResumeToken, ""},
+	}
+	for _, tt := range tests {
+		backlog, sub, err := b.subscribe(tt.token)
+		if err != tt.err {
+			t.Errorf("%s: error %v, want %v", tt.name, err, tt.err)
+			continue
+		}
+		if err != nil {
+			continue
+		}
+		b.unsubscribe(sub)
+
+		seqs := []uint64{}
+		for _, event := range backlog {
+			seqs = append(seqs, event.Seq)
+		}
+		if fmt.Sprint(seqs) != tt.want {
+			t.Errorf("%s: backlog %v, want %s", tt.name, seqs, tt.want)
+		}
+	}
+}
+
+func TestWatchDropsSlowSubscriber(t *testing.T) {
+	b := newTestBroker(10, 1)
+
+	received := make(chan uint64, 10)
+	release := make(chan struct{})
+	result := make(chan error)
+	go func() {
+		result <- b.Watch(context.Background(), "", func(event UserEvent) error {
+			received <- event.Seq
+			<-release // A consumer stuck on the first event
+			return nil
+		})
+	}()
+	waitForSubscribers(t, b, 1)
+
+	publishUsers(b, 1)
+	if seq := <-received; seq != 1 {
+		t.Fatalf("received event %d, want 1", seq)
+	}
+	// The watcher is now busy with event 1
+
+	// Event 2 fills the buffer and event 3 overflows it; neither publish blocks
+	published := make(chan struct{})
+	go func() {
+		publishUsers(b, 2)
+		close(published)
+	}()
+	select {
+	case <-published:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Publish blocked on a slow watcher")
+	}
+	waitForSubscribers(t, b, 0)
+
+	close(release)
+	err := <-result
+	if err != ErrSubscriberTooSlow {
+		t.Errorf("Watch returned %v, want ErrSubscriberTooSlow", err)
+	}
+	// Buffered events are still delivered, so the last token resumes without a gap
+	close(received)
+	var seqs []uint64
+	for seq := range received {
+		seqs = append(seqs, seq)
+	}
+	if fmt.Sprint(seqs) != "[2]" {
+		t.Errorf("delivered %v after event 1, want [2]", seqs)
+	}
+
+	backlog, sub, err := b.subscribe(tokenFor(b, 2))
+	if err != nil {
+		t.Fatal(err)
+	}
+	b.unsubscribe(sub)
+	if len(backlog) != 1 || backlog[0].Seq != 3 {
+		t.Errorf("resuming after the drop: backlog %v, want event 3", backlog)
+	}
+}
+
+func TestWatchStopsWithContext(t *testing.T) {
+	b := newTestBroker(10, 10)
+	ctx, cancel := context.WithCancel(context.Background())
+
+	result := make(chan error)
+	go func() {
+		result <- b.Watch(ctx, "", func(UserEvent) error { return nil })
+	}()
+	waitForSubscribers(t, b, 1)
+	cancel()
+
+	if err := <-result; !errors.Is(err, context.Canceled) {
+		t.Errorf("Watch returned %v, want context.Canceled", err)
+	}
+	waitForSubscribers(t, b, 0)
+}
\ No newline at end of file
@@ -1,96 +1,477 @@
 package service
 
 import (
+	"context"
 	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
 
+	"starter-kit-grpc-golang/config"
 	"starter-kit-grpc-golang/internal/models"
 	"starter-kit-grpc-golang/internal/repository"
+	"starter-kit-grpc-golang/pkg/filter"
 	"starter-kit-grpc-golang/pkg/utils"
+
+	"gorm.io/gorm/clause"
 )
 
 type UserService interface {
-	CreateUser(name, email, password, role string) (*models.User, error)
-	GetUserByID(id string) (*models.User, error)
-	GetUsers(filters map[string]interface{}, page, limit int32, sort string) ([]models.User, int64, error)
-	UpdateUser(id string, req UpdateUserDTO) (*models.User, error)
-	DeleteUser(id string) error
+	CreateUser(ctx context.Context, name, email, password, role string) (*models.User, error)
+	GetUserByID(ctx context.Context, id string) (*models.User, error)
+	GetUsers(ctx context.Context, query UserQuery, params PageParams) (*UserPage, error)
+	UpdateUser(ctx context.Context, id string, req UpdateUserDTO) (*models.User, error)
+	// DeleteUser deletes the user; a non-empty etag makes the delete conditional on it
+	DeleteUser(ctx context.Context, id, etag string) error
+	ChangeUserStatus(ctx context.Context, id, status, reason string) (*models.User, error)
+
+	BatchGetUsers(ctx context.Context, ids []string, allowPartial bool) ([]BatchResult, error)
+	BatchCreateUsers(ctx context.Context, items []CreateUserDTO, allowPartial bool) ([]BatchResult, error)
+	BatchDeleteUsers(ctx context.Context, ids []string, allowPartial bool) ([]BatchResult, error)
+
+	GetDeletedUsers(ctx context.Context, page, limit int32, sort string) ([]models.User, int64, error)
+	UndeleteUser(ctx context.Context, id string) (*models.User, error)
+	PurgeDeletedUsers(ctx context.Context, retention time.Duration) (int64, error)
+
+	// WatchUsers streams user changes after resumeToken ("" means from now) to fn,
+	// along with the token to resume after each event
+	WatchUsers(ctx context.Context, resumeToken string, fn func(event UserEvent, resumeToken string) error) error
 }
 
 type userService struct {
-	repo repository.UserRepository
+	repo         repository.UserRepository
+	tokenRepo    repository.TokenRepository
+	tx           repository.Transactor
+	auditService AuditService
+	userEvents   UserEventBroker
+	cfg          *config.Config
+}
+
+// PageParams selects a page by number (OFFSET, legacy) or by page token (keyset, AIP-158)
+type PageParams struct {
+	Page         int32
+	Limit        int32
+	Sort         string
+	PageToken    string
+	IncludeTotal bool // Except for legacy page numbers > 1, the total is only counted on request
+}
+
+// UserQuery narrows ListUsers. Filter is an AIP-160 expression over userFilterFields.
+type UserQuery struct {
+	Search string
+	Scope  string
+	Role   string
+	Filter string
 }
 
+// userFilterFields is the allowlist of fields usable in a ListUsers filter,
+// by proto name and JSON name
+var userFilterFields = filter.Fields{
+	"id":                {Column: "id", Type: filter.String},
+	"name":              {Column: "name", Type: filter.String},
+	"email":             {Column: "email", Type: filter.String},
+	"role":              {Column: "role", Type: filter.String},
+	"status":            {Column: "status", Type: filter.String},
+	"is_email_verified": {Column: "is_email_verified", Type: filter.Bool},
+	"isEmailVerified":   {Column: "is_email_verified", Type: filter.Bool},
+	"created_at":        {Column: "created_at", Type: filter.Timestamp},
+	"createdAt":         {Column: "created_at", Type: filter.Timestamp},
+	"updated_at":        {Column: "updated_at", Type: filter.Timestamp},
+	"updatedAt":         {Column: "updated_at", Type: filter.Timestamp},
+}
+
+type UserPage struct {
+	Users         []models.User
+	Total         int64  // 0 when the COUNT was skipped (see PageParams.IncludeTotal)
+	NextPageToken string // Empty on the last page
+}
+
+var (
+	ErrInvalidPageToken = &Error{
+		Kind:       KindValidation,
+		Reason:     "INVALID_PAGE_TOKEN",
+		Message:    utils.ErrInvalidPageToken.Error(),
+		Violations: []FieldViolation{{Field: "page_token"}},
+	}
+	ErrInvalidOrderBy = &Error{
+		Kind:       KindValidation,
+		Reason:     "INVALID_ORDER_BY",
+		Message:    utils.ErrInvalidOrderBy.Error(),
+		Violations: []FieldViolation{{Field: "order_by"}},
+	}
+	ErrInvalidFilter = &Error{
+		Kind:       KindValidation,
+		Reason:     "INVALID_FILTER",
+		Message:    "invalid filter",
+		Violations: []FieldViolation{{Field: "filter"}},
+	}
+	ErrInvalidETag = &Error{
+		Kind:       KindValidation,
+		Reason:     "INVALID_ETAG",
+		Message:    "invalid etag",
+		Violations: []FieldViolation{{Field: "etag"}},
+	}
+	ErrUserModified = &Error{
+		Kind:    KindConflict,
+		Reason:  "USER_MODIFIED",
+		Message: "user was modified concurrently, fetch it again and retry",
+	}
+	ErrEmailTaken = &Error{
+		Kind:       KindAlreadyExists,
+		Reason:     "EMAIL_TAKEN",
+		Message:    "email already taken",
+		Violations: []FieldViolation{{Field: "email"}},
+	}
+	ErrDeletedUserNotFound = &Error{Kind: KindNotFound, Reason: "DELETED_USER_NOT_FOUND", Message: "deleted user not found"}
+)
+
+// UpdateUserDTO holds the masked fields of an update, keyed by field mask path.
+// A present key means "set", even to an empty string.
 type UpdateUserDTO struct {
-	Name     string
-	Email    string
-	Password string
+	Fields map[string]string
+	ETag   string // If set, the update only applies to the user at this version
+}
+
+// userFieldSetters is the allowlist of update mask paths and how each one is applied.
+// Supporting a new updatable field means adding it to UserUpdate in the proto and here.
+var userFieldSetters = map[string]func(ctx context.Context, s *userService, user *models.User, value string) error{
+	"name": func(ctx context.Context, s *userService, user *models.User, value string) error {
+		user.Name = value
+		return nil
+	},
+	"email": func(ctx context.Context, s *userService, user *models.User, value string) error {
+		if value == "" {
+			return fieldError("email", "email cannot be empty")
+		}
+		if value == user.Email {
+			return nil
+		}
+		if exists, _ := s.repo.ExistsByEmail(ctx, value); exists {
+			return ErrEmailTaken
+		}
+		user.Email = value
+		return nil
+	},
+	"password": func(ctx context.Context, s *userService, user *models.User, value string) error {
+		if value == "" {
+			return fieldError("password", "password cannot be empty")
+		}
+		user.Password = value // Will be hashed by GORM hook
+		return nil
+	},
+}
+
+// UpdatableUserFields returns the field mask paths accepted by UpdateUser
+func UpdatableUserFields() []string {
+	paths := make([]string, 0, len(userFieldSetters))
+	for path := range userFieldSetters {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	return paths
+}
+
+func NewUserService(repo repository.UserRepository, tokenRepo repository.TokenRepository, tx repository.Transactor, auditService AuditService, userEvents UserEventBroker, cfg *config.Config) UserService {
+	return &userService{repo: repo, tokenRepo: tokenRepo, tx: tx, auditService: auditService, userEvents: userEvents, cfg: cfg}
+}
+
+func (s *userService) CreateUser(ctx context.Context, name, email, password, role string) (*models.User, error) {
+	user, err := createUser(ctx, s.repo, CreateUserDTO{Name: name, Email: email, Password: password, Role: role})
+	if err != nil {
+		return nil, err
+	}
+	s.auditService.Record(ctx, AuditEntry{
+		Type:     models.AuditUserCreated,
+		TargetID: user.ID,
+		Details:  map[string]string{"email": user.Email, "role": user.Role},
+	})
+	s.userEvents.Publish(UserEventCreated, user)
+	return user, nil
+}
+
+func (s *userService) GetUserByID(ctx context.Context, id string) (*models.User, error) {
+	user, err := s.repo.FindByID(ctx, id)
+	if err != nil {
+		return nil, lookupError(err, ErrUserNotFound)
+	}
+	return user, nil
+}
+
+func (s *userService) GetUsers(ctx context.Context, query UserQuery, params PageParams) (*UserPage, error) {
+	// Parse errors are *filter.Error and carry the offending position
+	where, err := parseUserFilter(query.Filter)
+	if err != nil {
+		return nil, err
+	}
+
+	paginationScope := &utils.PaginationScope{
+		Page:  params.Page,
+		Limit: params.Limit,
+		Sort:  params.Sort,
+	}
+
+	// Page numbers beyond the first keep the legacy OFFSET behaviour. Keyset pages,
+	// the first one included, only pay for the COUNT when it is asked for.
+	paginationScope.Keyset = params.PageToken != "" || params.Page <= 1
+	paginationScope.SkipCount = paginationScope.Keyset && !params.IncludeTotal
+
+	// Tokens are bound to the query they were issued for
+	fingerprint := utils.QueryFingerprint(query.Search, query.Scope, query.Role, query.Filter, params.Sort)
+
+	if params.PageToken != "" {
+		cursor, err := utils.DecodePageToken(params.PageToken, s.cfg.PageTokenSecret, fingerprint)
+		if err != nil {
+			return nil, ErrInvalidPageToken.wrap(err)
+		}
+		paginationScope.After = cursor
+	}
+
+	users, total, next, err := s.repo.FindAll(ctx, repository.UserFilter{
+		Search: query.Search,
+		Scope:  query.Scope,
+		Role:   query.Role,
+		Where:  where,
+	}, paginationScope)
+	if err != nil {
+		return nil, orderByError(err)
+	}
+
+	page := &UserPage{Users: users, Total: total}
+	if next != nil {
+		next.Query = fingerprint
+		if page.NextPageToken, err = utils.EncodePageToken(next, s.cfg.PageTokenSecret); err != nil {
+			return nil, err
+		}
+	}
+	return page, nil
 }
 
-func NewUserService(repo repository.UserRepository) UserService {
-	return &userService{repo: repo}
+func (s *userService) UpdateUser(ctx context.Context, id string, req UpdateUserDTO) (*models.User, error) {
+	version, err := parseETag(req.ETag)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := s.repo.FindByID(ctx, id)
+	if err != nil {
+		return nil, lookupError(err, ErrUserNotFound)
+	}
+	if version != 0 && version != user.Version {
+		return nil, ErrUserModified
+	}
+
+	// Apply only the masked fields, in a stable order
+	paths := make([]string, 0, len(req.Fields))
+	for path := range req.Fields {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	for _, path := range paths {
+		setter, ok := userFieldSetters[path]
+		if !ok {
+			return nil, fieldError("update_mask", fmt.Sprintf("field %q cannot be updated", path))
+		}
+		if err := setter(ctx, s, user, req.Fields[path]); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := s.repo.Update(ctx, user); err != nil {
+		return nil, versionError(err)
+	}
+	s.auditService.Record(ctx, AuditEntry{
+		Type:     models.AuditUserUpdated,
+		TargetID: user.ID,
+		Details:  changedFields(req),
+	})
+	s.userEvents.Publish(UserEventUpdated, user)
+	return user, nil
 }
 
-func (s *userService) CreateUser(name, email, password, role string) (*models.User, error) {
-	if exists, _ := s.repo.ExistsByEmail(email); exists {
-		return nil, errors.New("email already taken")
+func (s *userService) DeleteUser(ctx context.Context, id, etag string) error {
+	version, err := parseETag(etag)
+	if err != nil {
+		return err
+	}
+	// Delete and revoke sessions together, as BatchDeleteUsers does
+	err = s.tx.Transaction(ctx, func(tx repository.TxRepositories) error {
+		return deleteUser(ctx, tx.Users, tx.Tokens, id, version)
+	})
+	if err != nil {
+		return err
+	}
+
+	s.auditService.Record(ctx, AuditEntry{Type: models.AuditUserDeleted, TargetID: id})
+	s.userEvents.Publish(UserEventDeleted, &models.User{ID: id})
+	return nil
+}
+
+// createUser is shared by CreateUser and BatchCreateUsers
+func createUser(ctx context.Context, repo repository.UserRepository, dto CreateUserDTO) (*models.User, error) {
+	if exists, _ := repo.ExistsByEmail(ctx, dto.Email); exists {
+		return nil, ErrEmailTaken
 	}
 
 	user := &models.User{
-		Name:     name,
-		Email:    email,
-		Password: password, // Hashed by GORM hook
-		Role:     role,
+		Name:     dto.Name,
+		Email:    dto.Email,
+		Password: dto.Password, // Hashed by GORM hook
+		Role:     dto.Role,
 	}
 
-	if err := s.repo.Create(user); err != nil {
+	if err := repo.Create(ctx, user); err != nil {
 		return nil, err
 	}
 	return user, nil
 }
 
-func (s *userService) GetUserByID(id string) (*models.User, error) {
-	return s.repo.FindByID(id)
+// deleteUser is shared by DeleteUser and BatchDeleteUsers. A non-zero version makes it conditional.
+func deleteUser(ctx context.Context, repo repository.UserRepository, tokenRepo repository.TokenRepository, id string, version int64) error {
+	user, err := repo.FindByID(ctx, id)
+	if err != nil {
+		return lookupError(err, ErrUserNotFound)
+	}
+	if version != 0 && version != user.Version {
+		return ErrUserModified
+	}
+	if err := repo.Delete(ctx, id, version); err != nil {
+		return versionError(err)
+	}
+
+	// Soft delete doesn't trigger the FK cascade, so revoke sessions explicitly
+	return tokenRepo.DeleteByUserID(ctx, id)
 }
 
-func (s *userService) GetUsers(filters map[string]interface{}, page, limit int32, sort string) ([]models.User, int64, error) {
+func (s *userService) GetDeletedUsers(ctx context.Context, page, limit int32, sort string) ([]models.User, int64, error) {
 	paginationScope := &utils.PaginationScope{
 		Page:  page,
 		Limit: limit,
 		Sort:  sort,
 	}
 
-	return s.repo.FindAll(filters, paginationScope)
+	users, total, err := s.repo.FindDeleted(ctx, paginationScope)
+	if err != nil {
+		return nil, 0, orderByError(err)
+	}
+	return users, total, nil
 }
 
-func (s *userService) UpdateUser(id string, req UpdateUserDTO) (*models.User, error) {
-	user, err := s.repo.FindByID(id)
+func (s *userService) UndeleteUser(ctx context.Context, id string) (*models.User, error) {
+	if err := s.repo.Restore(ctx, id); err != nil {
+		return nil, lookupError(err, ErrDeletedUserNotFound)
+	}
+	s.auditService.Record(ctx, AuditEntry{Type: models.AuditUserRestored, TargetID: id})
+
+	user, err := s.repo.FindByID(ctx, id)
 	if err != nil {
-		return nil, errors.New("user not found")
+		return nil, err
 	}
+	s.userEvents.Publish(UserEventUpdated, user)
+	return user, nil
+}
 
-	// Update fields if provided
-	if req.Email != "" && req.Email != user.Email {
-		if exists, _ := s.repo.ExistsByEmail(req.Email); exists {
-			return nil, errors.New("email already taken")
-		}
-		user.Email = req.Email
+func (s *userService) PurgeDeletedUsers(ctx context.Context, retention time.Duration) (int64, error) {
+	return s.repo.PurgeDeletedBefore(ctx, time.Now().Add(-retention))
+}
+
+func (s *userService) WatchUsers(ctx context.Context, resumeToken string, fn func(event UserEvent, resumeToken string) error) error {
+	return s.userEvents.Watch(ctx, resumeToken, func(event UserEvent) error {
+		return fn(event, s.userEvents.ResumeToken(event))
+	})
+}
+
+func (s *userService) ChangeUserStatus(ctx context.Context, id, status, reason string) (*models.User, error) {
+	switch status {
+	case models.UserStatusActive, models.UserStatusSuspended, models.UserStatusDeactivated:
+	default:
+		return nil, fieldError("status", "invalid status")
 	}
-	if req.Name != "" {
-		user.Name = req.Name
+
+	user, err := s.repo.FindByID(ctx, id)
+	if err != nil {
+		return nil, lookupError(err, ErrUserNotFound)
 	}
-	if req.Password != "" {
-		user.Password = req.Password // Will be hashed by GORM hook
+
+	now := time.Now()
+	previousStatus := user.Status
+	user.Status = status
+	user.StatusReason = reason
+	user.StatusChangedAt = &now
+	if err := s.repo.Update(ctx, user); err != nil {
+		return nil, versionError(err)
 	}
+	s.auditService.Record(ctx, AuditEntry{
+		Type:     models.AuditUserStatusChanged,
+		TargetID: user.ID,
+		Details:  map[string]string{"from": previousStatus, "to": status, "reason": reason},
+	})
+	s.userEvents.Publish(UserEventUpdated, user)
 
-	if err := s.repo.Update(user); err != nil {
-		return nil, err
+	// Revoke sessions immediately; access tokens are rejected by AuthInterceptor within seconds (it caches statuses briefly)
+	if status != models.UserStatusActive {
+		if err := s.tokenRepo.DeleteByUserIDAndType(ctx, user.ID, models.TokenTypeRefresh); err != nil {
+			return nil, err
+		}
 	}
 	return user, nil
 }
 
-func (s *userService) DeleteUser(id string) error {
-	if _, err := s.repo.FindByID(id); err != nil {
-		return errors.New("user not found")
+// changedFields lists which fields an update touched, without their (possibly secret) values
+func changedFields(req UpdateUserDTO) map[string]string {
+	changed := map[string]string{}
+	for path, value := range req.Fields {
+		if path == "email" {
+			changed[path] = value
+			continue
+		}
+		changed[path] = "changed"
+	}
+	return changed
+}
+
+// parseETag extracts the version from an etag as produced by models.User.ETag.
+// Weak tags are accepted, and "" or "*" (any version) yield 0.
+func parseETag(etag string) (int64, error) {
+	etag = strings.TrimPrefix(strings.TrimSpace(etag), "W/")
+	if etag == "" || etag == "*" {
+		return 0, nil
+	}
+	version, err := strconv.ParseInt(strings.Trim(etag, `"`), 10, 64)
+	if err != nil || version < 1 {
+		return 0, ErrInvalidETag
+	}
+	return version, nil
+}
+
+// parseUserFilter parses an AIP-160 filter over userFilterFields
+func parseUserFilter(input string) (clause.Expression, error) {
+	where, err := filter.ParseClause(input, userFilterFields)
+	if err != nil {
+		typed := ErrInvalidFilter.wrap(err)
+		var filterErr *filter.Error
+		if errors.As(err, &filterErr) {
+			typed.Metadata = map[string]string{"position": strconv.Itoa(filterErr.Pos)}
+		}
+		return nil, typed
+	}
+	return where, nil
+}
+
+// orderByError types the invalid order_by error raised while building a listing query
+func orderByError(err error) error {
+	if errors.Is(err, utils.ErrInvalidOrderBy) {
+		return ErrInvalidOrderBy.wrap(err)
+	}
+	return err
+}
+
+// versionError turns a lost compare-and-swap into ErrUserModified
+func versionError(err error) error {
+	if errors.Is(err, repository.ErrVersionConflict) {
+		return ErrUserModified
 	}
-	return s.repo.Delete(id)
+	return err
 }
\ No newline at end of file
@@ -0,0 +1,36 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"starter-kit-grpc-golang/config"
+	"starter-kit-grpc-golang/internal/models"
+	"starter-kit-grpc-golang/internal/repository"
+	"starter-kit-grpc-golang/internal/testutil"
+)
+
+func TestDeleteUserRollsBackWhenRevocationFails(t *testing.T) {
+	db := testutil.NewDB(t)
+	users := repository.NewUserRepository(db)
+	svc := NewUserService(users, repository.NewTokenRepository(db), repository.NewTransactor(db),
+		NewAuditService(repository.NewAuditRepository(db, testAuditSecret), testAuditSecret),
+		NewUserEventBroker(&config.Config{}), &config.Config{})
+
+	ctx := context.Background()
+	user := &models.User{Name: "Ann", Email: "ann@example.com", Password: "password1", Role: models.RoleUser}
+	if err := users.Create(ctx, user); err != nil {
+		t.Fatal(err)
+	}
+	// Without the tokens table, revoking sessions fails after the soft delete
+	if err := db.Migrator().DropTable(&models.Token{}); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := svc.DeleteUser(ctx, user.ID, ""); err == nil {
+		t.Fatal("DeleteUser succeeded without a tokens table")
+	}
+	if _, err := users.FindByID(ctx, user.ID); err != nil {
+		t.Errorf("user was deleted although revoking sessions failed: %v", err)
+	}
+}
\ No newline at end of file
@@ -0,0 +1,44 @@
+// Package testutil provides fixtures shared by the package tests
+package testutil
+
+import (
+	"io"
+	"log/slog"
+	"testing"
+
+	"starter-kit-grpc-golang/config"
+	"starter-kit-grpc-golang/pkg/logger"
+
+	"github.com/glebarez/sqlite"
+	"gorm.io/gorm"
+	gormlogger "gorm.io/gorm/logger"
+)
+
+func init() {
+	// Code under test logs through logger.Log, which main normally sets up
+	if logger.Log == nil {
+		logger.Log = slog.New(slog.NewTextHandler(io.Discard, nil))
+	}
+}
+
+// NewDB returns a migrated in-memory SQLite database that lives as long as the test
+func NewDB(t testing.TB) *gorm.DB {
+	t.Helper()
+
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{Logger: gormlogger.Discard})
+	if err != nil {
+		t.Fatalf("open test database: %v", err)
+	}
+	sqlDB, err := db.DB()
+	if err != nil {
+		t.Fatalf("open test database: %v", err)
+	}
+	// Every connection to ":memory:" is a separate database, so keep to one
+	sqlDB.SetMaxOpenConns(1)
+	t.Cleanup(func() { sqlDB.Close() })
+
+	if err := config.Migrate(db); err != nil {
+		t.Fatalf("migrate test database: %v", err)
+	}
+	return db
+}
\ No newline at end of file
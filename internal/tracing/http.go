@@ -0,0 +1,39 @@
+package tracing
+
+import (
+	"net/http"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// GatewayHandler starts a server span for each gateway request, continuing any
+// traceparent the client sent
+func GatewayHandler(next http.Handler) http.Handler {
+	traced := otelhttp.NewHandler(next, "gateway",
+		otelhttp.WithSpanNameFormatter(func(operation string, r *http.Request) string {
+			return r.Method // Refined to the route by GatewayMiddleware
+		}),
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// otelhttp renames the span after the root mux's catch-all "/" pattern when
+		// the request finishes, which would undo GatewayMiddleware
+		r = r.WithContext(r.Context())
+		r.Pattern = ""
+		traced.ServeHTTP(w, r)
+	})
+}
+
+// GatewayMiddleware names the gateway span after the matched route, e.g. "GET /v1/users/{id=*}"
+func GatewayMiddleware(next runtime.HandlerFunc) runtime.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+		if pattern, ok := runtime.HTTPPattern(r.Context()); ok {
+			span := trace.SpanFromContext(r.Context())
+			span.SetName(r.Method + " " + pattern.String())
+			span.SetAttributes(attribute.String("http.route", pattern.String()))
+		}
+		next(w, r, pathParams)
+	}
+}
\ No newline at end of file
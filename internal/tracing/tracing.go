@@ -0,0 +1,97 @@
+// Package tracing sets up OpenTelemetry tracing: the exporter, W3C trace-context
+// propagation, and spans for the gateway, gRPC, GORM and SMTP
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"starter-kit-grpc-golang/config"
+
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc/filters"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.37.0"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/stats"
+)
+
+const instrumentationName = "starter-kit-grpc-golang"
+
+// Init installs the tracer provider selected by cfg.Tracing.Exporter ("otlp", "stdout" or "none").
+// The returned function flushes pending spans; call it on shutdown.
+func Init(ctx context.Context, cfg *config.Config) (func(context.Context) error, error) {
+	var exporter sdktrace.SpanExporter
+	var err error
+
+	switch cfg.Tracing.Exporter {
+	case "otlp":
+		// Endpoint, headers and TLS come from the standard OTEL_EXPORTER_OTLP_* variables
+		exporter, err = otlptracegrpc.New(ctx)
+	case "stdout":
+		exporter, err = stdouttrace.New(stdouttrace.WithWriter(os.Stdout))
+	case "none", "":
+		// Still propagate incoming trace context, so upstream traces aren't broken
+		otel.SetTextMapPropagator(Propagator())
+		return func(context.Context) error { return nil }, nil
+	default:
+		return nil, fmt.Errorf("unknown tracing exporter %q", cfg.Tracing.Exporter)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	provider := Install(exporter, cfg.Tracing.ServiceName)
+	return provider.Shutdown, nil
+}
+
+// Install registers a global tracer provider that batches spans to exporter
+func Install(exporter sdktrace.SpanExporter, serviceName string) *sdktrace.TracerProvider {
+	return install(sdktrace.WithBatcher(exporter), serviceName)
+}
+
+// InstallSync registers a global tracer provider that exports every span as it ends.
+// Meant for tests, e.g. with a tracetest.InMemoryExporter, so spans can be asserted
+// without flushing.
+func InstallSync(exporter sdktrace.SpanExporter, serviceName string) *sdktrace.TracerProvider {
+	return install(sdktrace.WithSyncer(exporter), serviceName)
+}
+
+func install(processor sdktrace.TracerProviderOption, serviceName string) *sdktrace.TracerProvider {
+	provider := sdktrace.NewTracerProvider(
+		processor,
+		sdktrace.WithResource(resource.NewSchemaless(semconv.ServiceName(serviceName))),
+	)
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(Propagator())
+	return provider
+}
+
+// Propagator carries W3C traceparent/tracestate and baggage
+func Propagator() propagation.TextMapPropagator {
+	return propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{})
+}
+
+// Tracer is used for the spans this package creates by hand
+func Tracer() trace.Tracer {
+	return otel.Tracer(instrumentationName)
+}
+
+// ServerHandler creates a server span per RPC, continuing the caller's trace from the
+// incoming metadata. Health checks are not traced.
+func ServerHandler() stats.Handler {
+	return otelgrpc.NewServerHandler(otelgrpc.WithFilter(filters.Not(filters.HealthCheck())))
+}
+
+// ClientDialOption makes the gateway's gRPC client inject the current trace context
+// into outgoing metadata
+func ClientDialOption() grpc.DialOption {
+	return grpc.WithStatsHandler(otelgrpc.NewClientHandler())
+}
\ No newline at end of file
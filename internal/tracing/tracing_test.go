@@ -0,0 +1,155 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	pb "starter-kit-grpc-golang/api/gen/v1"
+	"starter-kit-grpc-golang/internal/models"
+	"starter-kit-grpc-golang/internal/testutil"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"gorm.io/gorm"
+)
+
+// userServer answers GetUser with a database lookup, like the real handler
+type userServer struct {
+	pb.UnimplementedUserServiceServer
+	db *gorm.DB
+}
+
+func (s *userServer) GetUser(ctx context.Context, req *pb.GetUserRequest) (*pb.UserResponse, error) {
+	var user models.User
+	if err := s.db.WithContext(ctx).Take(&user, "id = ?", req.Id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, status.Error(codes.NotFound, "user not found")
+		}
+		return nil, err
+	}
+	return &pb.UserResponse{Id: user.ID, Name: user.Name}, nil
+}
+
+// newTracedGateway wires the gateway, the gRPC server and GORM the way main does,
+// over an in-process listener
+func newTracedGateway(t *testing.T) http.Handler {
+	t.Helper()
+
+	db := testutil.NewDB(t)
+	if err := db.Use(NewGORMPlugin()); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.Create(&models.User{ID: "user-1", Name: "Alice", Email: "alice@example.com"}).Error; err != nil {
+		t.Fatal(err)
+	}
+
+	listener := bufconn.Listen(1 << 20)
+	server := grpc.NewServer(grpc.StatsHandler(ServerHandler()))
+	pb.RegisterUserServiceServer(server, &userServer{db: db})
+	go server.Serve(listener)
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufconn",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		ClientDialOption(),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { conn.Close() })
+
+	gwmux := runtime.NewServeMux(runtime.WithMiddlewares(GatewayMiddleware))
+	if err := pb.RegisterUserServiceHandlerClient(context.Background(), gwmux, pb.NewUserServiceClient(conn)); err != nil {
+		t.Fatal(err)
+	}
+	return GatewayHandler(gwmux)
+}
+
+func TestRequestTrace(t *testing.T) {
+	exporter := tracetest.NewInMemoryExporter()
+	provider := InstallSync(exporter, "tracing-test")
+	t.Cleanup(func() { provider.Shutdown(context.Background()) })
+
+	gateway := newTracedGateway(t)
+
+	// The client's trace is continued, not replaced
+	const traceID = "4bf92f3577b34da6a3ce929d0e0e4736"
+	req := httptest.NewRequest(http.MethodGet, "/v1/users/user-1", nil)
+	req.Header.Set("traceparent", "00-"+traceID+"-00f067aa0ba902b7-01")
+	rec := httptest.NewRecorder()
+	gateway.ServeHTTP(rec, req)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status %d: %s", rec.Code, rec.Body)
+	}
+
+	spans := exporter.GetSpans()
+	find := func(name string, kind trace.SpanKind) tracetest.SpanStub {
+		t.Helper()
+		var names []string
+		for _, s := range spans {
+			if s.Name == name && s.SpanKind == kind {
+				return s
+			}
+			names = append(names, s.Name)
+		}
+		t.Fatalf("no %q span of kind %v in %q", name, kind, names)
+		return tracetest.SpanStub{}
+	}
+
+	// Client and server spans are both named after the RPC
+	gatewaySpan := find("GET /v1/users/{id=*}", trace.SpanKindServer)
+	clientSpan := find("v1.UserService/GetUser", trace.SpanKindClient)
+	serverSpan := find("v1.UserService/GetUser", trace.SpanKindServer)
+	querySpan := find("gorm.query", trace.SpanKindClient)
+
+	// gateway -> gRPC client -> gRPC server -> GORM, all in the caller's trace
+	chain := []struct {
+		name string
+		span tracetest.SpanStub
+	}{
+		{"gateway", gatewaySpan},
+		{"gRPC client", clientSpan},
+		{"gRPC server", serverSpan},
+		{"GORM", querySpan},
+	}
+	for i, link := range chain {
+		if got := link.span.SpanContext.TraceID().String(); got != traceID {
+			t.Errorf("%s span in trace %s, want %s", link.name, got, traceID)
+		}
+		if i == 0 {
+			if got := link.span.Parent.SpanID().String(); got != "00f067aa0ba902b7" {
+				t.Errorf("gateway span parent = %s, want the incoming traceparent", got)
+			}
+			continue
+		}
+		if parent := chain[i-1]; link.span.Parent.SpanID() != parent.span.SpanContext.SpanID() {
+			t.Errorf("%s span is not a child of the %s span", link.name, parent.name)
+		}
+	}
+}
+
+func TestInstallSyncExportsImmediately(t *testing.T) {
+	exporter := tracetest.NewInMemoryExporter()
+	provider := InstallSync(exporter, "tracing-test")
+	t.Cleanup(func() { provider.Shutdown(context.Background()) })
+
+	_, span := Tracer().Start(context.Background(), "work")
+	span.End()
+
+	if spans := exporter.GetSpans(); len(spans) != 1 || spans[0].Name != "work" {
+		t.Errorf("exported %v, want the span without a flush", spans.Snapshots())
+	}
+}
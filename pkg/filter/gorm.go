@@ -0,0 +1,152 @@
+package filter
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"gorm.io/gorm/clause"
+)
+
+// FieldType controls how values are parsed and which operators are allowed
+type FieldType int
+
+const (
+	String FieldType = iota
+	Bool
+	Int
+	Timestamp
+)
+
+// Field maps a filterable name to a DB column
+type Field struct {
+	Column string
+	Type   FieldType
+}
+
+// Fields is the allowlist of filterable names; anything else is rejected
+type Fields map[string]Field
+
+// ToClause translates a parsed filter into a parameterized GORM expression.
+// Only columns from the allowlist ever reach the SQL; values are always bound.
+func ToClause(expr Expr, fields Fields) (clause.Expression, error) {
+	switch e := expr.(type) {
+	case *Logical:
+		exprs := make([]clause.Expression, 0, len(e.Exprs))
+		for _, sub := range e.Exprs {
+			translated, err := ToClause(sub, fields)
+			if err != nil {
+				return nil, err
+			}
+			exprs = append(exprs, translated)
+		}
+		if e.Op == "OR" {
+			return clause.Or(exprs...), nil
+		}
+		return clause.And(exprs...), nil
+	case *Not:
+		translated, err := ToClause(e.Expr, fields)
+		if err != nil {
+			return nil, err
+		}
+		return clause.Not(translated), nil
+	case *Comparison:
+		return comparisonClause(e, fields)
+	}
+	return nil, &Error{Pos: expr.Position(), Msg: "unsupported expression"}
+}
+
+// ParseClause parses and translates in one step. An empty filter returns nil.
+func ParseClause(input string, fields Fields) (clause.Expression, error) {
+	expr, err := Parse(input)
+	if err != nil || expr == nil {
+		return nil, err
+	}
+	return ToClause(expr, fields)
+}
+
+func comparisonClause(c *Comparison, fields Fields) (clause.Expression, error) {
+	field, ok := fields[c.Field]
+	if !ok {
+		return nil, &Error{Pos: c.Pos, Msg: fmt.Sprintf("unknown field %q", c.Field)}
+	}
+	column := clause.Column{Name: field.Column}
+
+	// ":" (has) is a case-insensitive substring match on strings
+	if c.Operator == ":" {
+		if field.Type != String {
+			return nil, &Error{Pos: c.Pos, Msg: fmt.Sprintf("operator \":\" is not supported on field %q", c.Field)}
+		}
+		pattern := "%" + escapeLike(strings.ToLower(c.Value.Raw)) + "%"
+		return clause.Expr{SQL: "LOWER(?) LIKE ? ESCAPE '\\'", Vars: []interface{}{column, pattern}}, nil
+	}
+
+	// Trailing "*" on a string equality is a prefix match
+	if field.Type == String && strings.HasSuffix(c.Value.Raw, "*") && (c.Operator == "=" || c.Operator == "!=") {
+		pattern := escapeLike(strings.TrimSuffix(c.Value.Raw, "*")) + "%"
+		like := clause.Expr{SQL: "? LIKE ? ESCAPE '\\'", Vars: []interface{}{column, pattern}}
+		if c.Operator == "!=" {
+			return clause.Not(like), nil
+		}
+		return like, nil
+	}
+
+	value, err := parseValue(c, field)
+	if err != nil {
+		return nil, err
+	}
+
+	switch c.Operator {
+	case "=":
+		return clause.Eq{Column: column, Value: value}, nil
+	case "!=":
+		return clause.Neq{Column: column, Value: value}, nil
+	}
+
+	if field.Type == Bool {
+		return nil, &Error{Pos: c.Pos, Msg: fmt.Sprintf("operator %q is not supported on field %q", c.Operator, c.Field)}
+	}
+	switch c.Operator {
+	case "<":
+		return clause.Lt{Column: column, Value: value}, nil
+	case "<=":
+		return clause.Lte{Column: column, Value: value}, nil
+	case ">":
+		return clause.Gt{Column: column, Value: value}, nil
+	case ">=":
+		return clause.Gte{Column: column, Value: value}, nil
+	}
+	return nil, &Error{Pos: c.Pos, Msg: fmt.Sprintf("unsupported operator %q", c.Operator)}
+}
+
+func parseValue(c *Comparison, field Field) (interface{}, error) {
+	raw := c.Value.Raw
+	switch field.Type {
+	case Bool:
+		if !c.Value.Quoted {
+			if b, err := strconv.ParseBool(raw); err == nil && (raw == "true" || raw == "false") {
+				return b, nil
+			}
+		}
+		return nil, &Error{Pos: c.Value.Pos, Msg: fmt.Sprintf("field %q expects true or false", c.Field)}
+	case Int:
+		n, err := strconv.ParseInt(raw, 10, 64)
+		if err != nil {
+			return nil, &Error{Pos: c.Value.Pos, Msg: fmt.Sprintf("field %q expects an integer", c.Field)}
+		}
+		return n, nil
+	case Timestamp:
+		t, err := time.Parse(time.RFC3339, raw)
+		if err != nil {
+			return nil, &Error{Pos: c.Value.Pos, Msg: fmt.Sprintf("field %q expects an RFC 3339 timestamp", c.Field)}
+		}
+		// SQLite compares timestamps as text, which only orders them within one offset
+		return t.UTC(), nil
+	}
+	return raw, nil
+}
+
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(s)
+}
\ No newline at end of file
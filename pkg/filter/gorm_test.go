@@ -0,0 +1,144 @@
+package filter
+
+import (
+	"errors"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/glebarez/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+type person struct {
+	ID        int
+	Name      string
+	Active    bool
+	Age       int
+	CreatedAt time.Time
+}
+
+var personFields = Fields{
+	"name":       {Column: "name", Type: String},
+	"active":     {Column: "active", Type: Bool},
+	"age":        {Column: "age", Type: Int},
+	"created_at": {Column: "created_at", Type: Timestamp},
+}
+
+func newPeopleDB(t *testing.T) *gorm.DB {
+	t.Helper()
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{Logger: logger.Discard})
+	if err != nil {
+		t.Fatal(err)
+	}
+	sqlDB, _ := db.DB()
+	sqlDB.SetMaxOpenConns(1)
+	t.Cleanup(func() { sqlDB.Close() })
+
+	day := func(d int) time.Time { return time.Date(2025, 1, d, 0, 0, 0, 0, time.UTC) }
+	people := []person{
+		{ID: 1, Name: "Alice", Active: true, Age: 30, CreatedAt: day(1)},
+		{ID: 2, Name: "Albert", Active: false, Age: 45, CreatedAt: day(2)},
+		{ID: 3, Name: "Bob", Active: true, Age: 25, CreatedAt: day(3)},
+		{ID: 4, Name: "50% off_sale", Active: true, Age: 40, CreatedAt: day(4)},
+		{ID: 5, Name: "Carol", Active: false, Age: 35, CreatedAt: day(5)},
+	}
+	if err := db.AutoMigrate(&person{}); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.Create(&people).Error; err != nil {
+		t.Fatal(err)
+	}
+	return db
+}
+
+func TestParseClause(t *testing.T) {
+	db := newPeopleDB(t)
+
+	tests := []struct {
+		filter string
+		want   []int
+	}{
+		{``, []int{1, 2, 3, 4, 5}},
+		{`name = "Alice"`, []int{1}},
+		{`name != Alice`, []int{2, 3, 4, 5}},
+		{`name = Al*`, []int{1, 2}},
+		{`name != "Al*"`, []int{3, 4, 5}},
+		{`name:LI`, []int{1}},
+		{`name:"%"`, []int{4}},      // LIKE wildcards are matched literally
+		{`name = "50%*"`, []int{4}}, // ... also in prefixes
+		{`name = "_0*"`, []int{}},   // "_" is not a single-character wildcard
+		{`active = true`, []int{1, 3, 4}},
+		{`active != true`, []int{2, 5}},
+		{`age >= 35`, []int{2, 4, 5}},
+		{`age < 30 OR age > 40`, []int{2, 3}},
+		{`active = true age > 28`, []int{1, 4}},
+		{`NOT name:al`, []int{3, 5}},
+		{`-(active = true) age < 40`, []int{5}},
+		{`created_at > 2025-01-03T00:00:00Z`, []int{4, 5}},
+		{`created_at <= "2025-01-02T00:00:00Z"`, []int{1, 2}},
+		{`created_at < 2025-01-02T01:00:00+02:00`, []int{1}},
+	}
+	for _, tt := range tests {
+		where, err := ParseClause(tt.filter, personFields)
+		if err != nil {
+			t.Errorf("ParseClause(%q): %v", tt.filter, err)
+			continue
+		}
+		query := db.Model(&person{})
+		if where != nil {
+			query = query.Where(where)
+		}
+		var ids []int
+		if err := query.Order("id").Pluck("id", &ids).Error; err != nil {
+			t.Errorf("query %q: %v", tt.filter, err)
+			continue
+		}
+		sort.Ints(ids)
+		if !equalInts(ids, tt.want) {
+			t.Errorf("filter %q matched %v, want %v", tt.filter, ids, tt.want)
+		}
+	}
+}
+
+func TestParseClauseErrors(t *testing.T) {
+	tests := []struct {
+		filter string
+		pos    int
+		msg    string
+	}{
+		{`password = "x"`, 0, `unknown field "password"`},
+		{`age = 1 OR secret:x`, 11, `unknown field "secret"`},
+		{`active = "true"`, 9, "expects true or false"},
+		{`active = yes`, 9, "expects true or false"},
+		{`active > true`, 0, `operator ">" is not supported`},
+		{`age = 1.5`, 6, "expects an integer"},
+		{`age:3`, 0, `operator ":" is not supported`},
+		{`created_at > 2025-01-01`, 13, "expects an RFC 3339 timestamp"},
+	}
+	for _, tt := range tests {
+		_, err := ParseClause(tt.filter, personFields)
+		var filterErr *Error
+		if !errors.As(err, &filterErr) {
+			t.Errorf("ParseClause(%q) = %v, want a filter error", tt.filter, err)
+			continue
+		}
+		if filterErr.Pos != tt.pos || !strings.Contains(filterErr.Msg, tt.msg) {
+			t.Errorf("ParseClause(%q) = %v, want position %d and %q", tt.filter, err, tt.pos, tt.msg)
+		}
+	}
+}
+
+func equalInts(a, b []int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
\ No newline at end of file
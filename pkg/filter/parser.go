@@ -0,0 +1,320 @@
+// Package filter implements a safe subset of the AIP-160 filtering language.
+//
+// Supported grammar:
+//
+//	expression  = sequence { "OR" sequence }
+//	sequence    = factor { ["AND"] factor }       // juxtaposition means AND
+//	factor      = ["NOT" | "-"] term
+//	term        = "(" expression ")" | comparison
+//	comparison  = field operator value
+//	operator    = "=" | "!=" | "<" | "<=" | ">" | ">=" | ":"
+//	value       = string | number | "true" | "false" | bareword
+//
+// Timestamps may be quoted or bare (created_at > 2025-01-01T00:00:00Z).
+// Strings may be single or double quoted. A trailing "*" on a string compared
+// with "=" is a prefix match, and ":" (has) is a case-insensitive substring match.
+package filter
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+const (
+	// MaxLength bounds the filter string so parsing cost stays trivial
+	MaxLength = 1024
+	// MaxDepth bounds nesting of parentheses and NOT
+	MaxDepth = 16
+)
+
+// Error is a parse or validation error with the byte offset where it occurred
+type Error struct {
+	Pos int
+	Msg string
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("invalid filter at position %d: %s", e.Pos, e.Msg)
+}
+
+// Expr is a node of the parsed filter
+type Expr interface {
+	Position() int
+}
+
+// Logical combines expressions with AND or OR
+type Logical struct {
+	Pos   int
+	Op    string // "AND" or "OR"
+	Exprs []Expr
+}
+
+// Not negates an expression
+type Not struct {
+	Pos  int
+	Expr Expr
+}
+
+// Comparison is "field op value"
+type Comparison struct {
+	Pos      int
+	Field    string
+	Operator string
+	Value    Value
+}
+
+// Value is a literal; Quoted distinguishes "true" (string) from true (bool)
+type Value struct {
+	Pos    int
+	Raw    string
+	Quoted bool
+}
+
+func (e *Logical) Position() int    { return e.Pos }
+func (e *Not) Position() int        { return e.Pos }
+func (e *Comparison) Position() int { return e.Pos }
+
+// Parse parses a filter string. An empty (or blank) filter returns nil.
+func Parse(input string) (Expr, error) {
+	if len(input) > MaxLength {
+		return nil, &Error{Pos: MaxLength, Msg: fmt.Sprintf("filter exceeds %d characters", MaxLength)}
+	}
+
+	tokens, err := lex(input)
+	if err != nil {
+		return nil, err
+	}
+	if len(tokens) == 1 { // Only EOF
+		return nil, nil
+	}
+
+	p := &parser{tokens: tokens}
+	expr, err := p.parseExpression(0)
+	if err != nil {
+		return nil, err
+	}
+	if tok := p.peek(); tok.kind != tokEOF {
+		return nil, &Error{Pos: tok.pos, Msg: fmt.Sprintf("unexpected %q", tok.text)}
+	}
+	return expr, nil
+}
+
+// --- Lexer ---
+
+type tokenKind int
+
+const (
+	tokEOF tokenKind = iota
+	tokIdent
+	tokString
+	tokOperator
+	tokLParen
+	tokRParen
+	tokMinus
+)
+
+type token struct {
+	kind tokenKind
+	text string
+	pos  int
+}
+
+func lex(input string) ([]token, error) {
+	var tokens []token
+	i := 0
+	for i < len(input) {
+		c := input[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+		case c == '(':
+			tokens = append(tokens, token{tokLParen, "(", i})
+			i++
+		case c == ')':
+			tokens = append(tokens, token{tokRParen, ")", i})
+			i++
+		case c == '"' || c == '\'':
+			start := i
+			var sb strings.Builder
+			i++
+			closed := false
+			for i < len(input) {
+				if input[i] == '\\' && i+1 < len(input) {
+					sb.WriteByte(input[i+1])
+					i += 2
+					continue
+				}
+				if input[i] == c {
+					closed = true
+					i++
+					break
+				}
+				sb.WriteByte(input[i])
+				i++
+			}
+			if !closed {
+				return nil, &Error{Pos: start, Msg: "unterminated string"}
+			}
+			tokens = append(tokens, token{tokString, sb.String(), start})
+		case c == '=' || c == ':':
+			tokens = append(tokens, token{tokOperator, string(c), i})
+			i++
+		case c == '!' || c == '<' || c == '>':
+			start := i
+			if i+1 < len(input) && input[i+1] == '=' {
+				tokens = append(tokens, token{tokOperator, input[i : i+2], start})
+				i += 2
+				continue
+			}
+			if c == '!' {
+				return nil, &Error{Pos: start, Msg: `expected "!="`}
+			}
+			tokens = append(tokens, token{tokOperator, string(c), start})
+			i++
+		case c == '-' && (i+1 >= len(input) || !isDigit(input[i+1])):
+			tokens = append(tokens, token{tokMinus, "-", i})
+			i++
+		case isWordChar(rune(c)) || c == '-':
+			start := i
+			i++
+			// Words starting with a digit are values, where ':' belongs to a timestamp
+			// (2025-01-01T00:00:00Z) rather than being the has operator
+			value := isDigit(c) || c == '-'
+			for i < len(input) && (isWordChar(rune(input[i])) || value && input[i] == ':') {
+				i++
+			}
+			tokens = append(tokens, token{tokIdent, input[start:i], start})
+		default:
+			return nil, &Error{Pos: i, Msg: fmt.Sprintf("unexpected character %q", c)}
+		}
+	}
+	return append(tokens, token{tokEOF, "end of filter", len(input)}), nil
+}
+
+func isDigit(c byte) bool { return c >= '0' && c <= '9' }
+
+// isWordChar covers identifiers, numbers, wildcards and, with ':' allowed inside
+// values, bare RFC 3339 timestamps
+func isWordChar(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || strings.ContainsRune("_.-+*@", r)
+}
+
+// --- Parser ---
+
+type parser struct {
+	tokens []token
+	pos    int
+}
+
+func (p *parser) peek() token { return p.tokens[p.pos] }
+
+func (p *parser) next() token {
+	tok := p.tokens[p.pos]
+	if tok.kind != tokEOF {
+		p.pos++
+	}
+	return tok
+}
+
+func (p *parser) isKeyword(word string) bool {
+	tok := p.peek()
+	return tok.kind == tokIdent && tok.text == word
+}
+
+func (p *parser) parseExpression(depth int) (Expr, error) {
+	first, err := p.parseSequence(depth)
+	if err != nil {
+		return nil, err
+	}
+	exprs := []Expr{first}
+	for p.isKeyword("OR") {
+		p.next()
+		next, err := p.parseSequence(depth)
+		if err != nil {
+			return nil, err
+		}
+		exprs = append(exprs, next)
+	}
+	if len(exprs) == 1 {
+		return first, nil
+	}
+	return &Logical{Pos: first.Position(), Op: "OR", Exprs: exprs}, nil
+}
+
+func (p *parser) parseSequence(depth int) (Expr, error) {
+	first, err := p.parseFactor(depth)
+	if err != nil {
+		return nil, err
+	}
+	exprs := []Expr{first}
+	for {
+		tok := p.peek()
+		if tok.kind == tokEOF || tok.kind == tokRParen || p.isKeyword("OR") {
+			break
+		}
+		if p.isKeyword("AND") {
+			p.next()
+		}
+		next, err := p.parseFactor(depth)
+		if err != nil {
+			return nil, err
+		}
+		exprs = append(exprs, next)
+	}
+	if len(exprs) == 1 {
+		return first, nil
+	}
+	return &Logical{Pos: first.Position(), Op: "AND", Exprs: exprs}, nil
+}
+
+func (p *parser) parseFactor(depth int) (Expr, error) {
+	if depth > MaxDepth {
+		return nil, &Error{Pos: p.peek().pos, Msg: "filter is nested too deeply"}
+	}
+
+	tok := p.peek()
+	if p.isKeyword("NOT") || tok.kind == tokMinus {
+		p.next()
+		expr, err := p.parseFactor(depth + 1)
+		if err != nil {
+			return nil, err
+		}
+		return &Not{Pos: tok.pos, Expr: expr}, nil
+	}
+	return p.parseTerm(depth)
+}
+
+func (p *parser) parseTerm(depth int) (Expr, error) {
+	tok := p.next()
+	switch tok.kind {
+	case tokLParen:
+		expr, err := p.parseExpression(depth + 1)
+		if err != nil {
+			return nil, err
+		}
+		if closing := p.next(); closing.kind != tokRParen {
+			return nil, &Error{Pos: closing.pos, Msg: fmt.Sprintf("expected \")\", found %q", closing.text)}
+		}
+		return expr, nil
+	case tokIdent:
+		if tok.text == "AND" || tok.text == "OR" || tok.text == "NOT" {
+			return nil, &Error{Pos: tok.pos, Msg: fmt.Sprintf("expected field name, found %q", tok.text)}
+		}
+		op := p.next()
+		if op.kind != tokOperator {
+			return nil, &Error{Pos: op.pos, Msg: fmt.Sprintf("expected operator after %q, found %q", tok.text, op.text)}
+		}
+		valueTok := p.next()
+		if valueTok.kind != tokIdent && valueTok.kind != tokString {
+			return nil, &Error{Pos: valueTok.pos, Msg: fmt.Sprintf("expected value, found %q", valueTok.text)}
+		}
+		return &Comparison{
+			Pos:      tok.pos,
+			Field:    tok.text,
+			Operator: op.text,
+			Value:    Value{Pos: valueTok.pos, Raw: valueTok.text, Quoted: valueTok.kind == tokString},
+		}, nil
+	}
+	return nil, &Error{Pos: tok.pos, Msg: fmt.Sprintf("expected comparison, found %q", tok.text)}
+}
\ No newline at end of file
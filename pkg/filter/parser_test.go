@@ -0,0 +1,120 @@
+package filter
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+// format renders an expression compactly, e.g. (OR a=1 (AND b=2 (NOT c:"x")))
+func format(e Expr) string {
+	switch e := e.(type) {
+	case *Logical:
+		parts := make([]string, len(e.Exprs))
+		for i, sub := range e.Exprs {
+			parts[i] = format(sub)
+		}
+		return "(" + e.Op + " " + strings.Join(parts, " ") + ")"
+	case *Not:
+		return "(NOT " + format(e.Expr) + ")"
+	case *Comparison:
+		if e.Value.Quoted {
+			return fmt.Sprintf("%s%s%q", e.Field, e.Operator, e.Value.Raw)
+		}
+		return e.Field + e.Operator + e.Value.Raw
+	}
+	return "?"
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{`name = "Alice"`, `name="Alice"`},
+		{`age>=30`, `age>=30`},
+		{`age != -5`, `age!=-5`},
+		{`email:example.com`, `email:example.com`},
+
+		// AND binds tighter than OR; juxtaposition is AND
+		{`a=1 OR b=2 AND c=3`, `(OR a=1 (AND b=2 c=3))`},
+		{`a=1 AND b=2 OR c=3`, `(OR (AND a=1 b=2) c=3)`},
+		{`a=1 b=2 c=3`, `(AND a=1 b=2 c=3)`},
+		{`(a=1 OR b=2) c=3`, `(AND (OR a=1 b=2) c=3)`},
+		{`NOT a=1 OR -b=2`, `(OR (NOT a=1) (NOT b=2))`},
+		{`NOT (a=1 OR b=2)`, `(NOT (OR a=1 b=2))`},
+
+		// Quoting
+		{`name='O\'Brien'`, `name="O'Brien"`},
+		{`name="say \"hi\""`, `name="say \"hi\""`},
+		{`role="true"`, `role="true"`},
+		{`name="a b" OR name='c'`, `(OR name="a b" name="c")`},
+
+		// Wildcards stay in the value for the translation to handle
+		{`name=Al*`, `name=Al*`},
+
+		// Timestamps, bare or quoted
+		{`created_at > 2025-01-01T00:00:00Z`, `created_at>2025-01-01T00:00:00Z`},
+		{`created_at>=2025-01-01T10:30:00+02:00`, `created_at>=2025-01-01T10:30:00+02:00`},
+		{`created_at < "2025-01-01T00:00:00Z"`, `created_at<"2025-01-01T00:00:00Z"`},
+	}
+	for _, tt := range tests {
+		expr, err := Parse(tt.input)
+		if err != nil {
+			t.Errorf("Parse(%q): %v", tt.input, err)
+			continue
+		}
+		if got := format(expr); got != tt.want {
+			t.Errorf("Parse(%q) = %s, want %s", tt.input, got, tt.want)
+		}
+	}
+}
+
+func TestParseEmpty(t *testing.T) {
+	for _, input := range []string{"", "   ", "\t\n"} {
+		expr, err := Parse(input)
+		if expr != nil || err != nil {
+			t.Errorf("Parse(%q) = %v, %v, want nil, nil", input, expr, err)
+		}
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	tests := []struct {
+		input string
+		pos   int
+		msg   string
+	}{
+		{`name="Alice`, 5, "unterminated string"},
+		{`name=`, 5, "expected value"},
+		{`name Alice`, 5, "expected operator"},
+		{`name ! "x"`, 5, `expected "!="`},
+		{`AND = 1`, 0, "expected field name"},
+		{`(a=1`, 4, `expected ")"`},
+		{`a=1)`, 3, `unexpected ")"`},
+		{`a=1 OR`, 6, "expected comparison"},
+		{`a=1 # b=2`, 4, "unexpected character"},
+		{strings.Repeat("(", MaxDepth+2) + "a=1" + strings.Repeat(")", MaxDepth+2), MaxDepth + 1, "nested too deeply"},
+		{strings.Repeat("NOT ", MaxDepth+2) + "a=1", 4 * (MaxDepth + 1), "nested too deeply"},
+		{"name=" + strings.Repeat("x", MaxLength), MaxLength, "exceeds"},
+	}
+	for _, tt := range tests {
+		_, err := Parse(tt.input)
+		var filterErr *Error
+		if !errors.As(err, &filterErr) {
+			t.Errorf("Parse(%.40q) = %v, want a filter error", tt.input, err)
+			continue
+		}
+		if filterErr.Pos != tt.pos || !strings.Contains(filterErr.Msg, tt.msg) {
+			t.Errorf("Parse(%.40q) = %v, want position %d and %q", tt.input, err, tt.pos, tt.msg)
+		}
+	}
+}
+
+func TestParseDepthLimitIsInclusive(t *testing.T) {
+	input := strings.Repeat("(", MaxDepth) + "a=1" + strings.Repeat(")", MaxDepth)
+	if _, err := Parse(input); err != nil {
+		t.Errorf("%d levels of nesting: %v, want it accepted", MaxDepth, err)
+	}
+}
\ No newline at end of file
@@ -0,0 +1,65 @@
+package logger
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+	gormlogger "gorm.io/gorm/logger"
+)
+
+const slowQueryThreshold = 200 * time.Millisecond
+
+type gormLogger struct {
+	level gormlogger.LogLevel
+}
+
+// NewGormLogger routes GORM's logs through the request-scoped logger of the statement's
+// context, so queries run with db.WithContext(ctx) are tagged with the request ID.
+// It logs failed and slow queries; use LogMode(gormlogger.Info) to log every statement.
+func NewGormLogger() gormlogger.Interface {
+	return &gormLogger{level: gormlogger.Warn}
+}
+
+func (l *gormLogger) LogMode(level gormlogger.LogLevel) gormlogger.Interface {
+	return &gormLogger{level: level}
+}
+
+func (l *gormLogger) Info(ctx context.Context, msg string, data ...interface{}) {
+	if l.level >= gormlogger.Info {
+		FromContext(ctx).Info(fmt.Sprintf(msg, data...))
+	}
+}
+
+func (l *gormLogger) Warn(ctx context.Context, msg string, data ...interface{}) {
+	if l.level >= gormlogger.Warn {
+		FromContext(ctx).Warn(fmt.Sprintf(msg, data...))
+	}
+}
+
+func (l *gormLogger) Error(ctx context.Context, msg string, data ...interface{}) {
+	if l.level >= gormlogger.Error {
+		FromContext(ctx).Error(fmt.Sprintf(msg, data...))
+	}
+}
+
+func (l *gormLogger) Trace(ctx context.Context, begin time.Time, fc func() (sql string, rowsAffected int64), err error) {
+	if l.level <= gormlogger.Silent {
+		return
+	}
+
+	elapsed := time.Since(begin)
+	switch {
+	case err != nil && l.level >= gormlogger.Error && !errors.Is(err, gorm.ErrRecordNotFound):
+		sql, rows := fc()
+		FromContext(ctx).Error("Query failed", "sql", sql, "rows", rows, "duration", elapsed.String(), "error", err)
+	case elapsed > slowQueryThreshold && l.level >= gormlogger.Warn:
+		sql, rows := fc()
+		FromContext(ctx).Warn("Slow query", "sql", sql, "rows", rows, "duration", elapsed.String())
+	case l.level >= gormlogger.Info:
+		sql, rows := fc()
+		FromContext(ctx).Debug("Query", "sql", sql, "rows", rows, "duration", elapsed.String())
+	}
+}
\ No newline at end of file
@@ -3,6 +3,8 @@ package logger
 import (
 	"log/slog"
 	"os"
+
+	"starter-kit-grpc-golang/pkg/redact"
 )
 
 var Log *slog.Logger
@@ -25,6 +27,7 @@ func InitLogger(env string) {
 		handler = slog.NewTextHandler(os.Stdout, opts)
 	}
 
-	Log = slog.New(handler)
+	// Secrets and email addresses are scrubbed from every log line
+	Log = slog.New(redact.NewHandler(handler))
 	slog.SetDefault(Log)
 }
\ No newline at end of file
@@ -0,0 +1,94 @@
+package redact
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+)
+
+// sensitiveKeys are attribute keys whose values are always redacted
+var sensitiveKeys = map[string]bool{
+	"password":      true,
+	"token":         true,
+	"access_token":  true,
+	"refresh_token": true,
+	"secret":        true,
+	"authorization": true,
+}
+
+type handler struct {
+	slog.Handler
+}
+
+// NewHandler wraps h so that attributes named like secrets (see sensitiveKeys) are
+// redacted and email addresses in string attributes are masked, whoever logs them
+func NewHandler(h slog.Handler) slog.Handler {
+	return &handler{Handler: h}
+}
+
+func (h *handler) Handle(ctx context.Context, r slog.Record) error {
+	clean := slog.NewRecord(r.Time, r.Level, r.Message, r.PC)
+	r.Attrs(func(a slog.Attr) bool {
+		clean.AddAttrs(redactAttr(a))
+		return true
+	})
+	return h.Handler.Handle(ctx, clean)
+}
+
+func (h *handler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	clean := make([]slog.Attr, len(attrs))
+	for i, a := range attrs {
+		clean[i] = redactAttr(a)
+	}
+	return &handler{Handler: h.Handler.WithAttrs(clean)}
+}
+
+func (h *handler) WithGroup(name string) slog.Handler {
+	return &handler{Handler: h.Handler.WithGroup(name)}
+}
+
+func redactAttr(a slog.Attr) slog.Attr {
+	if sensitiveKeys[strings.ToLower(a.Key)] {
+		return slog.String(a.Key, Redacted)
+	}
+
+	v := a.Value.Resolve() // Runs LogValuers such as Proto
+	switch v.Kind() {
+	case slog.KindString:
+		return slog.String(a.Key, maskEmails(v.String()))
+	case slog.KindAny:
+		if err, ok := v.Any().(error); ok {
+			return slog.String(a.Key, maskEmails(err.Error()))
+		}
+	case slog.KindGroup:
+		group := v.Group()
+		clean := make([]slog.Attr, len(group))
+		for i, ga := range group {
+			clean[i] = redactAttr(ga)
+		}
+		return slog.Attr{Key: a.Key, Value: slog.GroupValue(clean...)}
+	}
+	return slog.Attr{Key: a.Key, Value: v}
+}
+
+// maskEmails masks every email address in free text, e.g. an error message
+func maskEmails(s string) string {
+	if !strings.Contains(s, "@") {
+		return s
+	}
+	words := strings.FieldsFunc(s, isDelimiter)
+	for _, w := range words {
+		if masked := Email(w); masked != w {
+			s = strings.ReplaceAll(s, w, masked)
+		}
+	}
+	return s
+}
+
+func isDelimiter(r rune) bool {
+	switch r {
+	case ' ', '\t', '\n', '"', '\'', ',', ';', '(', ')', '<', '>', '[', ']', '{', '}', '=', ':':
+		return true
+	}
+	return false
+}
\ No newline at end of file
@@ -0,0 +1,185 @@
+// Package redact keeps secrets and PII out of logs. Proto fields annotated with
+// (v1.sensitive) are replaced, and email addresses are masked.
+package redact
+
+import (
+	"log/slog"
+	"net/mail"
+	"strings"
+	"sync"
+
+	pb "starter-kit-grpc-golang/api/gen/v1"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// Redacted replaces sensitive values
+const Redacted = "[REDACTED]"
+
+// Message returns a copy of msg with sensitive fields replaced by Redacted (or cleared,
+// for non-string fields) and email fields masked. msg itself is not modified.
+func Message(msg proto.Message) proto.Message {
+	clone := proto.Clone(msg)
+	redactMessage(clone.ProtoReflect())
+	return clone
+}
+
+// HasSensitive reports whether msg, or a message nested in it, has a (v1.sensitive)
+// field set. An Any whose type isn't known here counts as sensitive.
+func HasSensitive(msg proto.Message) bool {
+	return hasSensitive(msg.ProtoReflect())
+}
+
+// Proto wraps msg so slog logs it as redacted JSON, e.g. log.Debug("...", "request", redact.Proto(req))
+func Proto(msg proto.Message) slog.LogValuer {
+	return protoValue{msg: msg}
+}
+
+type protoValue struct {
+	msg proto.Message
+}
+
+func (v protoValue) LogValue() slog.Value {
+	if v.msg == nil || !v.msg.ProtoReflect().IsValid() {
+		return slog.StringValue("null")
+	}
+	b, err := protojson.Marshal(Message(v.msg))
+	if err != nil {
+		return slog.StringValue("!ERROR " + err.Error())
+	}
+	return slog.StringValue(string(b))
+}
+
+// Email masks the local part of an address: "alice@example.com" -> "a***@example.com".
+// Values that aren't email addresses are returned unchanged.
+func Email(s string) string {
+	at := strings.LastIndexByte(s, '@')
+	if at < 1 || !isEmail(s) {
+		return s
+	}
+	return s[:1] + "***" + s[at:]
+}
+
+func isEmail(s string) bool {
+	addr, err := mail.ParseAddress(s)
+	return err == nil && addr.Address == s
+}
+
+func redactMessage(m protoreflect.Message) {
+	if a, ok := m.Interface().(*anypb.Any); ok {
+		redactAny(a)
+		return
+	}
+
+	// Collect first: the message must not be modified while ranging over it
+	var sensitive, emails []protoreflect.FieldDescriptor
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		switch kind := fieldKind(fd); {
+		case kind == kindSensitive:
+			sensitive = append(sensitive, fd)
+		case kind == kindEmail:
+			emails = append(emails, fd)
+		case fd.IsMap() || fd.Message() == nil:
+		case fd.IsList():
+			list := v.List()
+			for i := 0; i < list.Len(); i++ {
+				redactMessage(list.Get(i).Message())
+			}
+		default:
+			redactMessage(v.Message())
+		}
+		return true
+	})
+
+	for _, fd := range sensitive {
+		if fd.Kind() == protoreflect.StringKind && !fd.IsList() && !fd.IsMap() {
+			m.Set(fd, protoreflect.ValueOfString(Redacted))
+		} else {
+			m.Clear(fd)
+		}
+	}
+	for _, fd := range emails {
+		if fd.IsList() {
+			list := m.Mutable(fd).List()
+			for i := 0; i < list.Len(); i++ {
+				list.Set(i, protoreflect.ValueOfString(Email(list.Get(i).String())))
+			}
+			continue
+		}
+		m.Set(fd, protoreflect.ValueOfString(Email(m.Get(fd).String())))
+	}
+}
+
+func hasSensitive(m protoreflect.Message) bool {
+	if a, ok := m.Interface().(*anypb.Any); ok {
+		inner, err := a.UnmarshalNew()
+		return err != nil || hasSensitive(inner.ProtoReflect())
+	}
+
+	found := false
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		switch {
+		case fieldKind(fd) == kindSensitive:
+			found = true
+		case fd.IsMap() || fd.Message() == nil:
+		case fd.IsList():
+			list := v.List()
+			for i := 0; i < list.Len() && !found; i++ {
+				found = hasSensitive(list.Get(i).Message())
+			}
+		default:
+			found = hasSensitive(v.Message())
+		}
+		return !found
+	})
+	return found
+}
+
+// redactAny redacts the message packed in a. If the type isn't known here its fields
+// can't be told apart, so the whole value is dropped.
+func redactAny(a *anypb.Any) {
+	inner, err := a.UnmarshalNew()
+	if err != nil {
+		a.Value = nil
+		return
+	}
+	redactMessage(inner.ProtoReflect())
+	if err := a.MarshalFrom(inner); err != nil {
+		a.Value = nil
+	}
+}
+
+type kind int
+
+const (
+	kindPlain kind = iota
+	kindSensitive
+	kindEmail // String field holding an email address
+)
+
+// Kinds are looked up once per field
+var kindCache sync.Map // protoreflect.FullName -> kind
+
+func fieldKind(fd protoreflect.FieldDescriptor) kind {
+	if cached, ok := kindCache.Load(fd.FullName()); ok {
+		return cached.(kind)
+	}
+
+	k := kindPlain
+	if opts := fd.Options(); opts != nil {
+		if sensitive, _ := proto.GetExtension(opts, pb.E_Sensitive).(bool); sensitive {
+			k = kindSensitive
+		} else if rules, _ := proto.GetExtension(opts, pb.E_Rules).(*pb.FieldRules); rules.GetEmail() {
+			k = kindEmail
+		}
+	}
+	if k == kindPlain && fd.Kind() == protoreflect.StringKind && !fd.IsMap() && strings.HasSuffix(string(fd.Name()), "email") {
+		k = kindEmail
+	}
+
+	kindCache.Store(fd.FullName(), k)
+	return k
+}
\ No newline at end of file
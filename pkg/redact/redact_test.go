@@ -0,0 +1,170 @@
+package redact
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"strings"
+	"testing"
+
+	pb "starter-kit-grpc-golang/api/gen/v1"
+
+	"cloud.google.com/go/longrunning/autogen/longrunningpb"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+func TestEmail(t *testing.T) {
+	tests := []struct {
+		in, want string
+	}{
+		{"alice@example.com", "a***@example.com"},
+		{"a@example.com", "a***@example.com"},
+		{"not an email", "not an email"},
+		{"@example.com", "@example.com"},
+		{"", ""},
+	}
+	for _, tt := range tests {
+		if got := Email(tt.in); got != tt.want {
+			t.Errorf("Email(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestMessage(t *testing.T) {
+	req := &pb.BatchCreateUsersRequest{Requests: []*pb.CreateUserRequest{
+		{Name: "Alice", Email: "alice@example.com", Password: "hunter2hunter2"},
+		{Name: "Bob", Email: "bob@example.com", Password: "correct horse"},
+	}}
+
+	got := Message(req).(*pb.BatchCreateUsersRequest)
+	for i, r := range got.Requests {
+		if r.Password != Redacted {
+			t.Errorf("requests[%d].password = %q, want %q", i, r.Password, Redacted)
+		}
+		if !strings.Contains(r.Email, "***@example.com") {
+			t.Errorf("requests[%d].email = %q, want it masked", i, r.Email)
+		}
+	}
+	if got.Requests[0].Name != "Alice" {
+		t.Errorf("name = %q, want it unchanged", got.Requests[0].Name)
+	}
+	if req.Requests[0].Password != "hunter2hunter2" {
+		t.Error("Message modified its argument")
+	}
+}
+
+func TestMessageClearsSensitiveBytes(t *testing.T) {
+	req := &pb.ImportUsersRequest{Format: "csv", Content: []byte("name,email,password\nA,a@example.com,secret123\n")}
+
+	got := Message(req).(*pb.ImportUsersRequest)
+	if len(got.Content) != 0 {
+		t.Errorf("content = %q, want it cleared", got.Content)
+	}
+	if got.Format != "csv" {
+		t.Errorf("format = %q, want it unchanged", got.Format)
+	}
+}
+
+func TestMessageRedactsAny(t *testing.T) {
+	response, err := anypb.New(&pb.ImportUsersResponse{
+		Errors: []*pb.ImportRowError{{Row: 1, Email: "carol@example.com", Message: "duplicate email"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	op := &longrunningpb.Operation{Name: "operations/1", Done: true, Result: &longrunningpb.Operation_Response{Response: response}}
+
+	got := Message(op).(*longrunningpb.Operation)
+	inner, err := got.GetResponse().UnmarshalNew()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if email := inner.(*pb.ImportUsersResponse).Errors[0].Email; email != "c***@example.com" {
+		t.Errorf("packed email = %q, want it masked", email)
+	}
+
+	// Nothing of an unknown packed type can be told apart, so nothing is kept
+	unknown := &longrunningpb.Operation{Result: &longrunningpb.Operation_Response{Response: &anypb.Any{
+		TypeUrl: "type.googleapis.com/unknown.Message",
+		Value:   []byte("secret"),
+	}}}
+	if value := Message(unknown).(*longrunningpb.Operation).GetResponse().GetValue(); len(value) != 0 {
+		t.Errorf("unknown packed value = %q, want it dropped", value)
+	}
+}
+
+func TestProto(t *testing.T) {
+	out := Proto(&pb.LoginRequest{Email: "alice@example.com", Password: "hunter2"}).LogValue().String()
+	if strings.Contains(out, "hunter2") || strings.Contains(out, "alice@") {
+		t.Errorf("Proto logged %s, want it redacted", out)
+	}
+	if got := Proto(nil).LogValue().String(); got != "null" {
+		t.Errorf("Proto(nil) = %q, want null", got)
+	}
+}
+
+func TestHandler(t *testing.T) {
+	var buf bytes.Buffer
+	log := slog.New(NewHandler(slog.NewJSONHandler(&buf, nil)))
+
+	log.With("token", "abc").Info("msg",
+		"password", "hunter2",
+		"user", "alice@example.com",
+		"error", errors.New("no user bob@example.com"),
+		slog.Group("req", "authorization", "Bearer xyz", "count", 3),
+	)
+
+	var entry map[string]interface{}
+	if err := json.Unmarshal(buf.Bytes(), &entry); err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]interface{}{
+		"token":    Redacted,
+		"password": Redacted,
+		"user":     "a***@example.com",
+		"error":    "no user b***@example.com",
+	}
+	for key, value := range want {
+		if entry[key] != value {
+			t.Errorf("%s = %v, want %v", key, entry[key], value)
+		}
+	}
+	group, _ := entry["req"].(map[string]interface{})
+	if group["authorization"] != Redacted || group["count"] != float64(3) {
+		t.Errorf("req = %v, want authorization redacted and count kept", group)
+	}
+}
+
+func TestMessageKeepsPlainMessages(t *testing.T) {
+	req := &pb.GetUserRequest{Id: "7c6f4c1e-7b0a-4d8e-9a0f-2a0b8f1f2a3b"}
+	if got := Message(req); !proto.Equal(got, req) {
+		t.Errorf("Message(%v) = %v, want it unchanged", req, got)
+	}
+}
+
+func TestHasSensitive(t *testing.T) {
+	packed, err := anypb.New(&pb.ExportUsersResponse{Content: []byte("name,email\n")})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name string
+		msg  proto.Message
+		want bool
+	}{
+		{"plain", &pb.UserResponse{Id: "1", Email: "alice@example.com"}, false},
+		{"nested token", &pb.AuthResponse{Tokens: &pb.TokenPair{Access: &pb.TokenPair_TokenDetail{Token: "jwt"}}}, true},
+		{"sensitive field unset", &pb.AuthResponse{Tokens: &pb.TokenPair{Access: &pb.TokenPair_TokenDetail{Expires: "soon"}}}, false},
+		{"in a list", &pb.BatchCreateUsersRequest{Requests: []*pb.CreateUserRequest{{Name: "A"}, {Password: "secret123"}}}, true},
+		{"packed in Any", &longrunningpb.Operation{Result: &longrunningpb.Operation_Response{Response: packed}}, true},
+		{"unknown packed type", &longrunningpb.Operation{Result: &longrunningpb.Operation_Response{Response: &anypb.Any{TypeUrl: "type.googleapis.com/unknown.Message"}}}, true},
+	}
+	for _, tt := range tests {
+		if got := HasSensitive(tt.msg); got != tt.want {
+			t.Errorf("%s: HasSensitive = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
\ No newline at end of file
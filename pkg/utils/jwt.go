@@ -12,21 +12,27 @@ type TokenPayload struct {
 	UserID string `json:"sub"`  // Subject (User ID)
 	Role   string `json:"role"` // RBAC Role
 	Type   string `json:"type"` // "access" or "refresh"
+
+	EmailVerified bool `json:"email_verified,omitempty"` // Access tokens only
 	jwt.RegisteredClaims
 }
 
 // GenerateToken creates a signed JWT token
 func GenerateToken(userID string, role string, tokenType string, expires time.Duration, secret string) (string, time.Time, error) {
-	expirationTime := time.Now().Add(expires)
-
-	claims := &TokenPayload{
+	return GenerateTokenWithPayload(&TokenPayload{
 		UserID: userID,
 		Role:   role,
 		Type:   tokenType,
-		RegisteredClaims: jwt.RegisteredClaims{
-			ExpiresAt: jwt.NewNumericDate(expirationTime),
-			IssuedAt:  jwt.NewNumericDate(time.Now()),
-		},
+	}, expires, secret)
+}
+
+// GenerateTokenWithPayload signs a JWT with custom claims, filling in expiry and issue time
+func GenerateTokenWithPayload(claims *TokenPayload, expires time.Duration, secret string) (string, time.Time, error) {
+	expirationTime := time.Now().Add(expires)
+
+	claims.RegisteredClaims = jwt.RegisteredClaims{
+		ExpiresAt: jwt.NewNumericDate(expirationTime),
+		IssuedAt:  jwt.NewNumericDate(time.Now()),
 	}
 
 	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
@@ -0,0 +1,96 @@
+package utils
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+var ErrInvalidPageToken = errors.New("invalid page token")
+
+// Cursor marks the last row of a page for keyset pagination
+type Cursor struct {
+	Values []CursorValue `json:"v"`           // Sort key values of the last row, in sort order
+	ID     string        `json:"id"`          // Tiebreaker
+	Offset int           `json:"o,omitempty"` // Rows to skip, for orders that can't be seeked (search relevance)
+	Query  string        `json:"q,omitempty"` // Fingerprint of the filters/sort the token was issued for
+}
+
+// CursorValue keeps times typed so comparisons work on every driver
+type CursorValue struct {
+	S string     `json:"s,omitempty"`
+	T *time.Time `json:"t,omitempty"`
+}
+
+func NewCursorValue(v interface{}) CursorValue {
+	switch val := v.(type) {
+	case time.Time:
+		return CursorValue{T: &val}
+	case string:
+		return CursorValue{S: val}
+	}
+	return CursorValue{}
+}
+
+// Value returns the value to bind in a query
+func (v CursorValue) Value() interface{} {
+	if v.T != nil {
+		return *v.T
+	}
+	return v.S
+}
+
+// EncodePageToken serializes and signs a cursor into an opaque, URL-safe token
+func EncodePageToken(cursor *Cursor, secret string) (string, error) {
+	payload, err := json.Marshal(cursor)
+	if err != nil {
+		return "", err
+	}
+
+	encoded := base64.RawURLEncoding.EncodeToString(payload)
+	return encoded + "." + signPageToken(encoded, secret), nil
+}
+
+// DecodePageToken verifies the signature and that the token was issued for the same query
+func DecodePageToken(token, secret, query string) (*Cursor, error) {
+	encoded, signature, found := strings.Cut(token, ".")
+	if !found {
+		return nil, ErrInvalidPageToken
+	}
+
+	expected := signPageToken(encoded, secret)
+	if !hmac.Equal([]byte(signature), []byte(expected)) {
+		return nil, ErrInvalidPageToken
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, ErrInvalidPageToken
+	}
+
+	var cursor Cursor
+	if err := json.Unmarshal(payload, &cursor); err != nil {
+		return nil, ErrInvalidPageToken
+	}
+	if cursor.Query != query {
+		return nil, fmt.Errorf("%w: it does not match the request parameters", ErrInvalidPageToken)
+	}
+	return &cursor, nil
+}
+
+func signPageToken(encoded, secret string) string {
+	mac := hmac.New(sha256.New, []byte("page-token:"+secret))
+	mac.Write([]byte(encoded))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// QueryFingerprint hashes request parameters so a token can't be replayed against a different query
+func QueryFingerprint(parts ...string) string {
+	sum := sha256.Sum256([]byte(strings.Join(parts, "\x00")))
+	return base64.RawURLEncoding.EncodeToString(sum[:12])
+}
\ No newline at end of file
@@ -0,0 +1,101 @@
+package utils
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+const testSecret = "page-token-test-secret"
+
+func TestPageTokenRoundTrip(t *testing.T) {
+	created := time.Date(2025, 3, 1, 12, 30, 0, 0, time.UTC)
+	cursor := &Cursor{
+		Values: []CursorValue{NewCursorValue("admin"), NewCursorValue(created)},
+		ID:     "7c6f4c1e-7b0a-4d8e-9a0f-2a0b8f1f2a3b",
+		Query:  QueryFingerprint("", "", "", "", "role asc, created_at desc"),
+	}
+
+	token, err := EncodePageToken(cursor, testSecret)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.ContainsAny(token, "+/=") {
+		t.Errorf("token %q is not URL-safe", token)
+	}
+
+	got, err := DecodePageToken(token, testSecret, cursor.Query)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, cursor) {
+		t.Errorf("decoded %+v, want %+v", got, cursor)
+	}
+	// Times come back typed, so drivers bind them as timestamps rather than strings
+	if _, ok := got.Values[1].Value().(time.Time); !ok {
+		t.Errorf("time value decoded as %T", got.Values[1].Value())
+	}
+}
+
+func TestPageTokenRejectsTampering(t *testing.T) {
+	query := QueryFingerprint("alice")
+	token, err := EncodePageToken(&Cursor{ID: "a", Offset: 20, Query: query}, testSecret)
+	if err != nil {
+		t.Fatal(err)
+	}
+	encoded, signature, _ := strings.Cut(token, ".")
+
+	// A client rewriting the payload, e.g. to jump ahead, can't re-sign it
+	forged, err := EncodePageToken(&Cursor{ID: "a", Offset: 1000, Query: query}, testSecret)
+	if err != nil {
+		t.Fatal(err)
+	}
+	forgedPayload, _, _ := strings.Cut(forged, ".")
+
+	flipped := []byte(signature)
+	flipped[0] ^= 1
+
+	tests := map[string]string{
+		"empty":             "",
+		"no signature":      encoded,
+		"payload swapped":   forgedPayload + "." + signature,
+		"signature changed": encoded + "." + string(flipped),
+		"signature dropped": encoded + ".",
+		"not base64":        "!!!." + signPageToken("!!!", testSecret),
+		"not json":          "bm9wZQ." + signPageToken("bm9wZQ", testSecret),
+	}
+	for name, token := range tests {
+		if _, err := DecodePageToken(token, testSecret, query); !errors.Is(err, ErrInvalidPageToken) {
+			t.Errorf("%s: error %v, want ErrInvalidPageToken", name, err)
+		}
+	}
+
+	// Tokens don't carry over to a deployment with another secret
+	if _, err := DecodePageToken(token, "another-secret", query); !errors.Is(err, ErrInvalidPageToken) {
+		t.Errorf("other secret: error %v, want ErrInvalidPageToken", err)
+	}
+}
+
+func TestPageTokenBoundToQuery(t *testing.T) {
+	issued := QueryFingerprint("", "", "admin", "", "name asc")
+	token, err := EncodePageToken(&Cursor{ID: "a", Query: issued}, testSecret)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, parts := range [][]string{
+		{"", "", "user", "", "name asc"},   // Other role filter
+		{"", "", "admin", "", "name desc"}, // Other sort
+		{"", "", "admin", "name asc", ""},  // Same strings, other parameters
+	} {
+		_, err := DecodePageToken(token, testSecret, QueryFingerprint(parts...))
+		if !errors.Is(err, ErrInvalidPageToken) {
+			t.Errorf("reused for %q: error %v, want ErrInvalidPageToken", parts, err)
+		}
+	}
+	if _, err := DecodePageToken(token, testSecret, issued); err != nil {
+		t.Errorf("same query: %v", err)
+	}
+}
\ No newline at end of file
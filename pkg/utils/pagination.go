@@ -1,16 +1,41 @@
 package utils
 
 import (
+	"errors"
 	"fmt"
 	"strings"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type PaginationScope struct {
 	Page  int32
 	Limit int32
 	Sort  string
+
+	// Keyset mode (page tokens): rows strictly after the After cursor, no OFFSET
+	Keyset    bool
+	After     *Cursor
+	SkipCount bool // Skip the COUNT(*) query (total is then unknown)
+}
+
+// SortKey is one resolved ORDER BY column
+type SortKey struct {
+	Column string
+	Desc   bool
+}
+
+// PageSize returns the effective limit
+func (p *PaginationScope) PageSize() int {
+	limit := p.Limit
+	if limit < 1 {
+		limit = 10
+	}
+	if limit > 100 {
+		limit = 100
+	}
+	return int(limit)
 }
 
 // Paginate returns a GORM scope for pagination
@@ -21,43 +46,164 @@ func (p *PaginationScope) Paginate() func(db *gorm.DB) *gorm.DB {
 			page = 1
 		}
 
-		limit := p.Limit
-		if limit < 1 {
-			limit = 10
-		}
-		if limit > 100 {
-			limit = 100
-		}
+		limit := int32(p.PageSize())
 
 		offset := (page - 1) * limit
 		return db.Offset(int(offset)).Limit(int(limit))
 	}
 }
 
-// SortScope returns a GORM scope for sorting safely
+// ErrInvalidOrderBy is returned (via the GORM error) for malformed or unknown sort keys
+var ErrInvalidOrderBy = errors.New("invalid order_by")
+
+// ParseOrderBy parses an AIP-132 order_by ("role asc, name desc") into DB columns.
+// The legacy "field:direction" form is accepted per key. Direction defaults to asc,
+// and an empty order_by sorts by created_at desc.
+// allowedFields is a map of "Input Field Name" -> "DB Column Name"
+func ParseOrderBy(orderBy string, allowedFields map[string]string) ([]SortKey, error) {
+	if strings.TrimSpace(orderBy) == "" {
+		return []SortKey{{Column: "created_at", Desc: true}}, nil // Default
+	}
+
+	var keys []SortKey
+	seen := map[string]bool{}
+	for _, part := range strings.Split(orderBy, ",") {
+		var words []string
+		if field, direction, ok := strings.Cut(strings.TrimSpace(part), ":"); ok {
+			words = []string{field, direction}
+		} else {
+			words = strings.Fields(part)
+		}
+		if len(words) == 0 || len(words) > 2 {
+			return nil, fmt.Errorf("%w: malformed sort key %q", ErrInvalidOrderBy, strings.TrimSpace(part))
+		}
+
+		column, valid := allowedFields[words[0]]
+		if !valid {
+			return nil, fmt.Errorf("%w: unknown sort field %q", ErrInvalidOrderBy, words[0])
+		}
+		if seen[column] {
+			return nil, fmt.Errorf("%w: duplicate sort field %q", ErrInvalidOrderBy, words[0])
+		}
+		seen[column] = true
+
+		key := SortKey{Column: column}
+		if len(words) == 2 {
+			switch strings.ToLower(words[1]) {
+			case "asc":
+			case "desc":
+				key.Desc = true
+			default:
+				return nil, fmt.Errorf("%w: unknown direction %q for %q", ErrInvalidOrderBy, words[1], words[0])
+			}
+		}
+		keys = append(keys, key)
+	}
+	return keys, nil
+}
+
+// OrderKeys resolves the requested sort and ends it with "id" as a stable tiebreaker.
+// Keys after an explicit "id" are dropped, since id is unique.
+func (p *PaginationScope) OrderKeys(allowedFields map[string]string) ([]SortKey, error) {
+	keys, err := ParseOrderBy(p.Sort, allowedFields)
+	if err != nil {
+		return nil, err
+	}
+	for i, key := range keys {
+		if key.Column == "id" {
+			return keys[:i+1], nil
+		}
+	}
+	return append(keys, SortKey{Column: "id", Desc: keys[len(keys)-1].Desc}), nil
+}
+
+// SortScope returns a GORM scope for sorting safely. Invalid sorts fail the query
+// with ErrInvalidOrderBy.
 // allowedFields is a map of "Input Field Name" -> "DB Column Name"
 func (p *PaginationScope) SortScope(allowedFields map[string]string) func(db *gorm.DB) *gorm.DB {
 	return func(db *gorm.DB) *gorm.DB {
-		sortParam := p.Sort
-		if sortParam == "" {
-			return db.Order("created_at desc") // Default
+		keys, err := p.OrderKeys(allowedFields)
+		if err != nil {
+			db.AddError(err)
+			return db
+		}
+		for _, key := range keys {
+			db = db.Order(orderClause(key))
+		}
+		return db
+	}
+}
+
+// KeysetScope sorts like SortScope, seeks past the After cursor and fetches one
+// extra row so callers can tell if another page exists
+func (p *PaginationScope) KeysetScope(allowedFields map[string]string) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		keys, err := p.OrderKeys(allowedFields)
+		if err != nil {
+			db.AddError(err)
+			return db
 		}
 
-		// Expected format: "field:direction" (e.g., "name:asc")
-		parts := strings.Split(sortParam, ":")
-		rawField := parts[0]
-		direction := "asc"
-		if len(parts) > 1 && strings.ToLower(parts[1]) == "desc" {
-			direction = "desc"
+		if p.After != nil {
+			values := make([]interface{}, 0, len(keys))
+			for _, v := range p.After.Values {
+				values = append(values, v.Value())
+			}
+			values = append(values, p.After.ID)
+
+			if len(values) != len(keys) {
+				db.AddError(ErrInvalidPageToken)
+				return db
+			}
+			db = db.Where(seekCondition(keys, values))
 		}
 
-		// Map input field to DB column
-		dbColumn, valid := allowedFields[rawField]
-		if valid {
-			return db.Order(fmt.Sprintf("%s %s", dbColumn, direction))
+		for _, key := range keys {
+			db = db.Order(orderClause(key))
 		}
+		return db.Limit(p.PageSize() + 1)
+	}
+}
+
+// NextCursor builds the cursor for the row that ends the current page.
+// Values hold every order key but the trailing id, which is stored as ID.
+func (p *PaginationScope) NextCursor(allowedFields map[string]string, id string, valueOf func(column string) interface{}) *Cursor {
+	keys, err := p.OrderKeys(allowedFields)
+	if err != nil {
+		return nil
+	}
+	cursor := &Cursor{ID: id}
+	for _, key := range keys[:len(keys)-1] {
+		cursor.Values = append(cursor.Values, NewCursorValue(valueOf(key.Column)))
+	}
+	return cursor
+}
+
+// seekCondition expands the row-value comparison (k1, k2, ...) > (v1, v2, ...)
+// per column, so it works with mixed directions and on every driver:
+// k1 > v1 OR (k1 = v1 AND k2 > v2) OR ...
+func seekCondition(keys []SortKey, values []interface{}) clause.Expression {
+	var ors []clause.Expression
+	for i, key := range keys {
+		var ands []clause.Expression
+		for j := 0; j < i; j++ {
+			ands = append(ands, clause.Eq{Column: clause.Column{Name: keys[j].Column}, Value: values[j]})
+		}
+		column := clause.Column{Name: key.Column}
+		if key.Desc {
+			ands = append(ands, clause.Lt{Column: column, Value: values[i]})
+		} else {
+			ands = append(ands, clause.Gt{Column: column, Value: values[i]})
+		}
+		ors = append(ors, clause.And(ands...))
+	}
+	return clause.Or(ors...)
+}
 
-		// Fallback
-		return db.Order("created_at desc")
+func orderClause(key SortKey) string {
+	direction := "asc"
+	if key.Desc {
+		direction = "desc"
 	}
+	return fmt.Sprintf("%s %s", key.Column, direction)
 }
\ No newline at end of file
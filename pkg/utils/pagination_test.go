@@ -0,0 +1,168 @@
+package utils
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/glebarez/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+type pageRow struct {
+	ID        string
+	Role      string
+	CreatedAt time.Time
+}
+
+var pageRowSortFields = map[string]string{
+	"id":         "id",
+	"role":       "role",
+	"created_at": "created_at",
+}
+
+func newPageRowsDB(t *testing.T) *gorm.DB {
+	t.Helper()
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{Logger: logger.Discard})
+	if err != nil {
+		t.Fatal(err)
+	}
+	sqlDB, _ := db.DB()
+	sqlDB.SetMaxOpenConns(1)
+	t.Cleanup(func() { sqlDB.Close() })
+
+	if err := db.AutoMigrate(&pageRow{}); err != nil {
+		t.Fatal(err)
+	}
+	// Few distinct values, so pages keep ending inside runs of equal sort keys
+	base := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	var rows []pageRow
+	for i := 0; i < 23; i++ {
+		rows = append(rows, pageRow{
+			ID:        fmt.Sprintf("id-%02d", (i*7)%23),
+			Role:      []string{"admin", "user"}[i%2],
+			CreatedAt: base.Add(time.Duration(i%3) * time.Hour),
+		})
+	}
+	if err := db.Create(&rows).Error; err != nil {
+		t.Fatal(err)
+	}
+	return db
+}
+
+// walkPages follows page tokens to the end and returns the ids in page order
+func walkPages(t *testing.T, db *gorm.DB, sort string, limit int32) []string {
+	t.Helper()
+	query := QueryFingerprint(sort)
+	var ids []string
+	token := ""
+	for pages := 0; ; pages++ {
+		if pages > 100 {
+			t.Fatal("pagination does not terminate")
+		}
+		scope := &PaginationScope{Limit: limit, Sort: sort, Keyset: true}
+		if token != "" {
+			cursor, err := DecodePageToken(token, testSecret, query)
+			if err != nil {
+				t.Fatal(err)
+			}
+			scope.After = cursor
+		}
+
+		var rows []pageRow
+		if err := db.Scopes(scope.KeysetScope(pageRowSortFields)).Find(&rows).Error; err != nil {
+			t.Fatal(err)
+		}
+		size := scope.PageSize()
+		if len(rows) <= size {
+			for _, r := range rows {
+				ids = append(ids, r.ID)
+			}
+			return ids
+		}
+
+		rows = rows[:size]
+		for _, r := range rows {
+			ids = append(ids, r.ID)
+		}
+		last := rows[size-1]
+		next := scope.NextCursor(pageRowSortFields, last.ID, func(column string) interface{} {
+			switch column {
+			case "role":
+				return last.Role
+			case "created_at":
+				return last.CreatedAt
+			}
+			return nil
+		})
+		next.Query = query
+		var err error
+		if token, err = EncodePageToken(next, testSecret); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestKeysetPaginationWithTies(t *testing.T) {
+	db := newPageRowsDB(t)
+
+	for _, sort := range []string{
+		"",
+		"role asc",
+		"role desc",
+		"role asc, created_at desc",
+		"created_at asc, role desc",
+		"created_at desc, id asc",
+	} {
+		for _, limit := range []int32{1, 3, 5, 23, 100} {
+			// Every row exactly once, in the order a single unpaged query returns them
+			var want []string
+			scope := &PaginationScope{Sort: sort}
+			if err := db.Model(&pageRow{}).Scopes(scope.SortScope(pageRowSortFields)).Pluck("id", &want).Error; err != nil {
+				t.Fatal(err)
+			}
+
+			if got := walkPages(t, db, sort, limit); !reflect.DeepEqual(got, want) {
+				t.Errorf("order_by %q, limit %d: paged %v, want %v", sort, limit, got, want)
+			}
+		}
+	}
+}
+
+func TestSeekCondition(t *testing.T) {
+	db := newPageRowsDB(t)
+
+	// After ("admin", 01:00, "id-09") sorted by role asc, created_at desc, id desc:
+	// rows in the same role and hour must fall on the right side of the id tiebreaker
+	keys := []SortKey{{Column: "role"}, {Column: "created_at", Desc: true}, {Column: "id", Desc: true}}
+	hour := time.Date(2025, 1, 1, 1, 0, 0, 0, time.UTC)
+	after := []interface{}{"admin", hour, "id-09"}
+
+	var rows []pageRow
+	if err := db.Where(seekCondition(keys, after)).Find(&rows).Error; err != nil {
+		t.Fatal(err)
+	}
+	for _, r := range rows {
+		switch {
+		case r.Role == "user":
+		case r.CreatedAt.Before(hour):
+		case r.CreatedAt.Equal(hour) && r.ID < "id-09":
+		default:
+			t.Errorf("row %+v is not after the cursor", r)
+		}
+	}
+
+	var all []pageRow
+	db.Find(&all)
+	want := 0
+	for _, r := range all {
+		if r.Role == "user" || r.CreatedAt.Before(hour) || r.CreatedAt.Equal(hour) && r.ID < "id-09" {
+			want++
+		}
+	}
+	if len(rows) != want {
+		t.Errorf("seek returned %d rows, want %d", len(rows), want)
+	}
+}
\ No newline at end of file
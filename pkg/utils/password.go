@@ -14,4 +14,11 @@ func HashPassword(password string) (string, error) {
 func CheckPassword(password, hash string) bool {
 	err := bcrypt.CompareHashAndPassword([]byte(hash), []byte(password))
 	return err == nil
-}
\ No newline at end of file
+}
+
+// IsPasswordHash reports whether s is a bcrypt hash rather than a plain text password.
+// Length alone can't tell: a plain text password may be as long as a hash.
+func IsPasswordHash(s string) bool {
+	_, err := bcrypt.Cost([]byte(s))
+	return err == nil
+}
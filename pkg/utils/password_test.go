@@ -0,0 +1,32 @@
+package utils
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestIsPasswordHash(t *testing.T) {
+	hash, err := HashPassword("password1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !IsPasswordHash(hash) {
+		t.Errorf("IsPasswordHash(%q) = false, want true", hash)
+	}
+
+	// Long plain text passwords used to pass for hashes and were stored as is
+	for _, plain := range []string{"password1", strings.Repeat("a", 60), strings.Repeat("é", 36), "$2a$10$short"} {
+		if IsPasswordHash(plain) {
+			t.Errorf("IsPasswordHash(%q) = true, want false", plain)
+		}
+	}
+}
+
+func TestHashPasswordLimit(t *testing.T) {
+	if _, err := HashPassword(strings.Repeat("é", 36)); err != nil {
+		t.Errorf("72-byte password: %v", err)
+	}
+	if _, err := HashPassword(strings.Repeat("é", 37)); err == nil {
+		t.Error("74-byte password: want an error, bcrypt ignores bytes past 72")
+	}
+}
@@ -0,0 +1,165 @@
+// Package validator enforces the (v1.rules) field constraints declared in the protos
+package validator
+
+import (
+	"fmt"
+	"net/mail"
+	"strings"
+	"sync"
+	"unicode/utf8"
+
+	pb "starter-kit-grpc-golang/api/gen/v1"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Violation is one broken rule
+type Violation struct {
+	Field       string // Path from the request root, e.g. "user.email" or "requests[2].email"
+	Description string
+}
+
+func (v Violation) String() string {
+	return v.Field + " " + v.Description
+}
+
+// Validate checks msg, and the messages nested in it, against their field rules.
+// It returns nil if msg is valid.
+func Validate(msg proto.Message) []Violation {
+	var violations []Violation
+	validateMessage(msg.ProtoReflect(), "", &violations)
+	return violations
+}
+
+func validateMessage(m protoreflect.Message, prefix string, violations *[]Violation) {
+	fields := m.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		path := prefix + string(fd.Name())
+
+		if rules := fieldRules(fd); rules != nil {
+			checkField(m, fd, rules, path, violations)
+		}
+
+		// Nested messages carry their own rules
+		switch {
+		case fd.IsMap() || fd.Message() == nil:
+		case fd.IsList():
+			list := m.Get(fd).List()
+			for j := 0; j < list.Len(); j++ {
+				validateMessage(list.Get(j).Message(), fmt.Sprintf("%s[%d].", path, j), violations)
+			}
+		case m.Has(fd):
+			validateMessage(m.Get(fd).Message(), path+".", violations)
+		}
+	}
+}
+
+// Rules are looked up once per field
+var rulesCache sync.Map // protoreflect.FullName -> *pb.FieldRules (nil if none)
+
+func fieldRules(fd protoreflect.FieldDescriptor) *pb.FieldRules {
+	if cached, ok := rulesCache.Load(fd.FullName()); ok {
+		return cached.(*pb.FieldRules)
+	}
+
+	var rules *pb.FieldRules
+	if opts := fd.Options(); opts != nil && proto.HasExtension(opts, pb.E_Rules) {
+		rules, _ = proto.GetExtension(opts, pb.E_Rules).(*pb.FieldRules)
+	}
+	rulesCache.Store(fd.FullName(), rules)
+	return rules
+}
+
+func checkField(m protoreflect.Message, fd protoreflect.FieldDescriptor, rules *pb.FieldRules, path string, violations *[]Violation) {
+	add := func(field, format string, args ...interface{}) {
+		*violations = append(*violations, Violation{Field: field, Description: fmt.Sprintf(format, args...)})
+	}
+
+	// Required is the only rule that applies to unset fields
+	if !m.Has(fd) {
+		if rules.Required {
+			add(path, "is required")
+		}
+		return
+	}
+
+	if fd.IsList() {
+		list := m.Get(fd).List()
+		if rules.MaxItems > 0 && uint32(list.Len()) > rules.MaxItems {
+			add(path, "must have at most %d items", rules.MaxItems)
+		}
+		if fd.Kind() == protoreflect.StringKind {
+			for j := 0; j < list.Len(); j++ {
+				for _, d := range checkString(list.Get(j).String(), rules) {
+					add(fmt.Sprintf("%s[%d]", path, j), "%s", d)
+				}
+			}
+		}
+		return
+	}
+
+	switch fd.Kind() {
+	case protoreflect.StringKind:
+		for _, d := range checkString(m.Get(fd).String(), rules) {
+			add(path, "%s", d)
+		}
+	case protoreflect.BytesKind:
+		n := uint32(len(m.Get(fd).Bytes()))
+		if rules.MinLen > 0 && n < rules.MinLen {
+			add(path, "must be at least %d bytes", rules.MinLen)
+		}
+		if rules.MaxLen > 0 && n > rules.MaxLen {
+			add(path, "must be at most %d bytes", rules.MaxLen)
+		}
+		if rules.MaxBytes > 0 && n > rules.MaxBytes {
+			add(path, "must be at most %d bytes", rules.MaxBytes)
+		}
+	}
+}
+
+// checkString returns a description for each rule s breaks
+func checkString(s string, rules *pb.FieldRules) []string {
+	var broken []string
+
+	n := uint32(utf8.RuneCountInString(s))
+	if rules.MinLen > 0 && n < rules.MinLen {
+		broken = append(broken, fmt.Sprintf("must be at least %d characters", rules.MinLen))
+	}
+	if rules.MaxLen > 0 && n > rules.MaxLen {
+		broken = append(broken, fmt.Sprintf("must be at most %d characters", rules.MaxLen))
+	}
+	// Characters outside ASCII take several bytes, so max_len alone doesn't bound the size
+	if rules.MaxBytes > 0 && uint32(len(s)) > rules.MaxBytes {
+		broken = append(broken, fmt.Sprintf("must be at most %d bytes", rules.MaxBytes))
+	}
+	if rules.Email && !isEmail(s) {
+		broken = append(broken, "must be a valid email address")
+	}
+	if rules.Uuid {
+		if _, err := uuid.Parse(s); err != nil || len(s) != 36 {
+			broken = append(broken, "must be a UUID")
+		}
+	}
+	if len(rules.In) > 0 && !contains(rules.In, s) {
+		broken = append(broken, "must be one of: "+strings.Join(rules.In, ", "))
+	}
+	return broken
+}
+
+// isEmail accepts a bare address ("a@example.com"), not a display name form
+func isEmail(s string) bool {
+	addr, err := mail.ParseAddress(s)
+	return err == nil && addr.Address == s
+}
+
+func contains(values []string, s string) bool {
+	for _, v := range values {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
\ No newline at end of file
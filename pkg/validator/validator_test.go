@@ -0,0 +1,90 @@
+package validator
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	pb "starter-kit-grpc-golang/api/gen/v1"
+
+	"google.golang.org/protobuf/proto"
+)
+
+const testUUID = "7c6f4c1e-7b0a-4d8e-9a0f-2a0b8f1f2a3b"
+
+func TestValidate(t *testing.T) {
+	validUser := func() *pb.CreateUserRequest {
+		return &pb.CreateUserRequest{Name: "Alice", Email: "alice@example.com", Password: "password1"}
+	}
+
+	tests := []struct {
+		name string
+		msg  proto.Message
+		want []string // "field description"
+	}{
+		{"valid", validUser(), nil},
+		{"required", &pb.CreateUserRequest{}, []string{
+			"name is required",
+			"email is required",
+			"password is required",
+		}},
+		{"email and in", &pb.CreateUserRequest{Name: "A", Email: "Alice <alice@example.com>", Password: "password1", Role: "root"}, []string{
+			"email must be a valid email address",
+			"role must be one of: user, admin",
+		}},
+		{"min_len", &pb.CreateUserRequest{Name: "A", Email: "a@example.com", Password: "short"}, []string{
+			"password must be at least 8 characters",
+		}},
+		{"max_len counts characters", &pb.CreateUserRequest{Name: strings.Repeat("é", 100), Email: "a@example.com", Password: "password1"}, nil},
+		{"max_len", &pb.CreateUserRequest{Name: strings.Repeat("a", 101), Email: "a@example.com", Password: "password1"}, []string{
+			"name must be at most 100 characters",
+		}},
+		{"max_bytes fits", &pb.CreateUserRequest{Name: "A", Email: "a@example.com", Password: strings.Repeat("é", 36)}, nil},
+		// 40 characters, but 80 bytes: bcrypt would reject it
+		{"max_bytes", &pb.CreateUserRequest{Name: "A", Email: "a@example.com", Password: strings.Repeat("é", 40)}, []string{
+			"password must be at most 72 bytes",
+		}},
+		{"uuid", &pb.GetUserRequest{Id: "7c6f4c1e7b0a4d8e9a0f2a0b8f1f2a3b"}, []string{
+			"id must be a UUID",
+		}},
+		{"nested in a repeated message", &pb.BatchCreateUsersRequest{Requests: []*pb.CreateUserRequest{
+			validUser(),
+			validUser(),
+			{Name: "C", Email: "not-an-email", Password: "password1"},
+		}}, []string{
+			"requests[2].email must be a valid email address",
+		}},
+		{"nested in a message", &pb.UpdateUserRequest{Id: testUUID, User: &pb.UserUpdate{Email: "bad"}}, []string{
+			"user.email must be a valid email address",
+		}},
+		{"required repeated", &pb.BatchGetUsersRequest{}, []string{
+			"ids is required",
+		}},
+	}
+	for _, tt := range tests {
+		var got []string
+		for _, v := range Validate(tt.msg) {
+			got = append(got, v.String())
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: got %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestValidateReportsEveryRepeatedElement(t *testing.T) {
+	req := &pb.BatchCreateUsersRequest{Requests: []*pb.CreateUserRequest{
+		{Name: "A", Email: "a@example.com", Password: "short"},
+		{Name: "B", Email: "b@example.com", Password: "password1"},
+		{Email: "c@example.com", Password: strings.Repeat("€", 25)},
+	}}
+
+	var fields []string
+	for _, v := range Validate(req) {
+		fields = append(fields, v.Field)
+	}
+	want := []string{"requests[0].password", "requests[2].name", "requests[2].password"}
+	if !reflect.DeepEqual(fields, want) {
+		t.Errorf("violations on %q, want %q", fields, want)
+	}
+}
\ No newline at end of file